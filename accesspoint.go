@@ -0,0 +1,93 @@
+package s3
+
+import (
+	"fmt"
+	"strings"
+)
+
+// accessPoint is a parsed S3 access point ARN, e.g.
+// "arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point"
+//
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/using-access-points.html
+type accessPoint struct {
+	partition string
+	region    string
+	account   string
+	name      string
+}
+
+// partitionDomains are the domains of the AWS partitions
+var partitionDomains = map[string]string{
+	"aws":        s3awshost,
+	"aws-cn":     s3awshost + `.cn`,
+	"aws-us-gov": s3awshost,
+}
+
+func parseAccessPointARN(arn string) (accessPoint, error) {
+	invalid := func(reason string) (accessPoint, error) {
+		return accessPoint{}, fmt.Errorf("s3: invalid access point ARN %q: %s", arn, reason)
+	}
+	f := strings.SplitN(arn, `:`, 6)
+	if len(f) != 6 || f[0] != "arn" || f[2] != "s3" {
+		return invalid("not an S3 ARN")
+	}
+	ap := accessPoint{partition: f[1], region: f[3], account: f[4]}
+	if _, ok := partitionDomains[ap.partition]; !ok {
+		return invalid("unknown partition")
+	}
+	if ap.region == "" {
+		return invalid("missing region")
+	}
+	if len(ap.account) != 12 || strings.Trim(ap.account, "0123456789") != "" {
+		return invalid("invalid account id")
+	}
+	// the resource is "accesspoint/<name>" or "accesspoint:<name>"
+	res := f[5]
+	if !strings.HasPrefix(res, "accesspoint/") && !strings.HasPrefix(res, "accesspoint:") {
+		return invalid("not an access point")
+	}
+	ap.name = res[len("accesspoint/"):]
+	if ap.name == "" || strings.Trim(ap.name, "abcdefghijklmnopqrstuvwxyz0123456789-") != "" {
+		return invalid("invalid access point name")
+	}
+	return ap, nil
+}
+
+// host returns the host of the access point
+func (ap accessPoint) host(dualStack bool) string {
+	h := ap.name + `-` + ap.account + `.` + s3servicehost + `-accesspoint.`
+	if dualStack {
+		h += `dualstack.`
+	}
+	return h + ap.region + `.` + partitionDomains[ap.partition]
+}
+
+// accessPoint returns the configured access point, if it is valid
+func (s3 *S3) accessPoint() (accessPoint, bool) {
+	if s3.AccessPointARN == "" {
+		return accessPoint{}, false
+	}
+	ap, err := parseAccessPointARN(s3.AccessPointARN)
+	return ap, err == nil
+}
+
+// checkAccessPoint returns an error if the configured access point can't be
+// used
+func (s3 *S3) checkAccessPoint() error {
+	if s3.AccessPointARN == "" {
+		return nil
+	}
+	if _, err := parseAccessPointARN(s3.AccessPointARN); err != nil {
+		return err
+	}
+	if s3.UseAccelerate {
+		return fmt.Errorf("s3: access points can't use transfer acceleration")
+	}
+	return nil
+}
+
+// signV4 reports if requests are signed with signature version 4, which
+// access points require
+func (s3 *S3) signV4() bool {
+	return s3.SignatureVersion == 4 || s3.AccessPointARN != ""
+}
@@ -0,0 +1,88 @@
+package s3
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseAccessPointARN(t *testing.T) {
+	for _, v := range []struct {
+		arn  string
+		host string
+	}{
+		{"arn:aws:s3:us-west-2:123456789012:accesspoint/my-ap", "my-ap-123456789012.s3-accesspoint.us-west-2.amazonaws.com"},
+		{"arn:aws:s3:eu-west-1:123456789012:accesspoint:ap2", "ap2-123456789012.s3-accesspoint.eu-west-1.amazonaws.com"},
+		{"arn:aws-cn:s3:cn-north-1:123456789012:accesspoint/ap", "ap-123456789012.s3-accesspoint.cn-north-1.amazonaws.com.cn"},
+	} {
+		ap, err := parseAccessPointARN(v.arn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if x := ap.host(false); x != v.host {
+			t.Fatal(x)
+		}
+	}
+
+	for _, arn := range []string{
+		"",
+		"my-ap",
+		"arn:aws:s3:us-west-2:123456789012",
+		"arn:aws:sqs:us-west-2:123456789012:accesspoint/my-ap",
+		"arn:other:s3:us-west-2:123456789012:accesspoint/my-ap",
+		"arn:aws:s3::123456789012:accesspoint/my-ap",
+		"arn:aws:s3:us-west-2:1234:accesspoint/my-ap",
+		"arn:aws:s3:us-west-2:123456789012:bucket/my-ap",
+		"arn:aws:s3:us-west-2:123456789012:accesspoint/",
+		"arn:aws:s3:us-west-2:123456789012:accesspoint/My_AP",
+		"arn:aws:s3:us-west-2:123456789012:accesspoint/my-ap/object/key",
+	} {
+		if _, err := parseAccessPointARN(arn); err == nil {
+			t.Fatal(arn)
+		}
+	}
+}
+
+func TestAccessPoint(t *testing.T) {
+	var host, auth string
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, auth = r.Host, r.Header.Get("Authorization")
+		if r.URL.Path != "/dir/key" {
+			w.WriteHeader(404)
+		}
+	}))
+	s3.Bucket = "bucket"
+	s3.Region = "eu-west-1"
+	s3.PathStyle = true
+	s3.AccessPointARN = "arn:aws:s3:us-west-2:123456789012:accesspoint/my-ap"
+
+	if _, err := s3.Object("dir/key").Head(); err != nil {
+		t.Fatal(err)
+	}
+	if host != "my-ap-123456789012.s3-accesspoint.us-west-2.amazonaws.com" {
+		t.Fatal(host)
+	}
+	// signed with V4 for the region of the access point
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 ") || !strings.Contains(auth, "/us-west-2/s3/aws4_request") {
+		t.Fatal(auth)
+	}
+
+	s3.UseDualStack = true
+	u, err := s3.Object("dir/key").ExpiringURL(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Host != "my-ap-123456789012.s3-accesspoint.dualstack.us-west-2.amazonaws.com" || u.Path != "/dir/key" || u.Query().Get("X-Amz-Algorithm") == "" {
+		t.Fatal(u)
+	}
+
+	// malformed ARNs are rejected
+	s3.AccessPointARN = "arn:aws:s3:us-west-2:123456789012:my-ap"
+	if _, err := s3.Object("dir/key").Head(); err == nil || !strings.Contains(err.Error(), "invalid access point ARN") {
+		t.Fatal(err)
+	}
+	if _, err := s3.List(""); err == nil {
+		t.Fatal("expected error")
+	}
+}
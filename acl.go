@@ -0,0 +1,128 @@
+package s3
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+// AccessControlPolicy is the access control list of an object
+type AccessControlPolicy struct {
+	Owner  Owner
+	Grants []Grant `xml:"AccessControlList>Grant"`
+}
+
+// Owner identifies the owner of an object
+type Owner struct {
+	ID          string
+	DisplayName string `xml:",omitempty"`
+}
+
+// Grant grants a permission to a grantee
+type Grant struct {
+	Grantee Grantee
+
+	// Permission is one of FULL_CONTROL, WRITE, WRITE_ACP, READ or READ_ACP
+	Permission string
+}
+
+// Permissions of grants
+const (
+	PermissionFullControl = "FULL_CONTROL"
+	PermissionWrite       = "WRITE"
+	PermissionWriteACP    = "WRITE_ACP"
+	PermissionRead        = "READ"
+	PermissionReadACP     = "READ_ACP"
+)
+
+// Predefined groups of Group grantees
+const (
+	AllUsersGroup           = "http://acs.amazonaws.com/groups/global/AllUsers"
+	AuthenticatedUsersGroup = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+	LogDeliveryGroup        = "http://acs.amazonaws.com/groups/s3/LogDelivery"
+)
+
+// Grantee is the receiver of a grant. Depending on the type, either the ID,
+// the URI or the email address is set.
+type Grantee struct {
+	// Type is one of CanonicalUser, AmazonCustomerByEmail or Group
+	Type string `xml:"http://www.w3.org/2001/XMLSchema-instance type,attr"`
+
+	ID           string
+	DisplayName  string
+	URI          string
+	EmailAddress string
+}
+
+// CanonicalUser returns the grantee for the account with the canonical user
+// id
+func CanonicalUser(id string) Grantee {
+	return Grantee{Type: "CanonicalUser", ID: id}
+}
+
+// EmailUser returns the grantee for the account with the email address. Only
+// some regions support email grantees.
+func EmailUser(email string) Grantee {
+	return Grantee{Type: "AmazonCustomerByEmail", EmailAddress: email}
+}
+
+// Group returns the grantee for a predefined group, e.g. AllUsersGroup
+func Group(uri string) Grantee {
+	return Grantee{Type: "Group", URI: uri}
+}
+
+// xsiNamespace is the namespace of the xsi:type attribute of grantees
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// MarshalXML encodes the type as the xsi:type attribute, which S3 requires
+// with the conventional xsi prefix
+func (g Grantee) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = []xml.Attr{
+		{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+		{Name: xml.Name{Local: "xsi:type"}, Value: g.Type},
+	}
+	v := struct {
+		ID           string `xml:",omitempty"`
+		DisplayName  string `xml:",omitempty"`
+		URI          string `xml:",omitempty"`
+		EmailAddress string `xml:",omitempty"`
+	}{g.ID, g.DisplayName, g.URI, g.EmailAddress}
+	return e.EncodeElement(v, start)
+}
+
+func (o *object) ACL() (*AccessControlPolicy, error) {
+	req, err := o.newRequest(context.Background(), "GET", "?acl", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := o.s3.send(req, 200, "error getting acl")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var policy AccessControlPolicy
+	if err := xml.NewDecoder(resp.Body).Decode(&policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (o *object) SetACL(acl ACL) error {
+	req, err := o.newRequest(context.Background(), "PUT", "?acl", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Amz-Acl", string(acl))
+
+	resp, err := o.s3.send(req, 200, "error setting acl")
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (o *object) SetACLPolicy(policy *AccessControlPolicy) error {
+	return o.putXML("?acl", policy, nil, "error setting acl")
+}
@@ -0,0 +1,115 @@
+package s3
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+const testACL = `<?xml version="1.0" encoding="UTF-8"?>
+<AccessControlPolicy xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Owner>
+    <ID>75aa57f09aa0c8caeab4f8c24e99d10f8e7faeebf76c078efc7c6caea54ba06a</ID>
+    <DisplayName>CustomersName@amazon.com</DisplayName>
+  </Owner>
+  <AccessControlList>
+    <Grant>
+      <Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser">
+        <ID>75aa57f09aa0c8caeab4f8c24e99d10f8e7faeebf76c078efc7c6caea54ba06a</ID>
+        <DisplayName>CustomersName@amazon.com</DisplayName>
+      </Grantee>
+      <Permission>FULL_CONTROL</Permission>
+    </Grant>
+    <Grant>
+      <Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="Group">
+        <URI>http://acs.amazonaws.com/groups/global/AllUsers</URI>
+      </Grantee>
+      <Permission>READ</Permission>
+    </Grant>
+  </AccessControlList>
+</AccessControlPolicy>`
+
+func TestACL(t *testing.T) {
+	var acl string
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.URL.Query()["acl"]; !ok {
+			t.Error(r.URL)
+		}
+		if r.Method == "PUT" {
+			acl = r.Header.Get("X-Amz-Acl")
+			return
+		}
+		w.Write([]byte(testACL))
+	}))
+	o := s3.Object("key")
+
+	p, err := o.ACL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := p.Owner.ID; x != "75aa57f09aa0c8caeab4f8c24e99d10f8e7faeebf76c078efc7c6caea54ba06a" {
+		t.Fatal(x)
+	}
+	if x := len(p.Grants); x != 2 {
+		t.Fatal(x)
+	}
+	if g := p.Grants[0]; g.Grantee.Type != "CanonicalUser" || g.Grantee.ID != p.Owner.ID || g.Permission != "FULL_CONTROL" {
+		t.Fatal(g)
+	}
+	if g := p.Grants[1]; g.Grantee.Type != "Group" || g.Grantee.URI != "http://acs.amazonaws.com/groups/global/AllUsers" || g.Permission != "READ" {
+		t.Fatal(g)
+	}
+
+	if err := o.SetACL(PublicRead); err != nil {
+		t.Fatal(err)
+	}
+	if acl != "public-read" {
+		t.Fatal(acl)
+	}
+}
+
+func TestSetACLPolicy(t *testing.T) {
+	var body []byte
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.URL.Query()["acl"]; !ok || r.Method != "PUT" {
+			t.Error(r.Method, r.URL)
+		}
+		if x := r.Header.Get("X-Amz-Acl"); x != "" {
+			t.Error(x)
+		}
+		body, _ = ioutil.ReadAll(r.Body)
+		if r.Header.Get("Content-MD5") != contentMD5(body) {
+			t.Error("invalid md5")
+		}
+	}))
+
+	policy := &AccessControlPolicy{
+		Owner: Owner{ID: "owner"},
+		Grants: []Grant{
+			{Grantee: CanonicalUser("owner"), Permission: PermissionFullControl},
+			{Grantee: CanonicalUser("reader"), Permission: PermissionRead},
+			{Grantee: Group(AllUsersGroup), Permission: PermissionReadACP},
+		},
+	}
+	if err := s3.Object("key").SetACLPolicy(policy); err != nil {
+		t.Fatal(err)
+	}
+	expected := `<AccessControlPolicy><Owner><ID>owner</ID></Owner><AccessControlList>` +
+		`<Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>owner</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant>` +
+		`<Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>reader</ID></Grantee><Permission>READ</Permission></Grant>` +
+		`<Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="Group"><URI>http://acs.amazonaws.com/groups/global/AllUsers</URI></Grantee><Permission>READ_ACP</Permission></Grant>` +
+		`</AccessControlList></AccessControlPolicy>`
+	if x := string(body); x != expected {
+		t.Fatal(x)
+	}
+
+	// the policy can be read back
+	var p AccessControlPolicy
+	if err := xml.Unmarshal(body, &p); err != nil {
+		t.Fatal(err)
+	}
+	if g := p.Grants[1]; g.Grantee.Type != "CanonicalUser" || g.Grantee.ID != "reader" || g.Permission != PermissionRead {
+		t.Fatal(g)
+	}
+}
@@ -0,0 +1,85 @@
+package s3
+
+import (
+	"context"
+	"encoding/xml"
+	"strings"
+)
+
+// Object attributes that can be requested with Attributes
+const (
+	AttributeETag         = "ETag"
+	AttributeChecksum     = "Checksum"
+	AttributeObjectParts  = "ObjectParts"
+	AttributeStorageClass = "StorageClass"
+	AttributeObjectSize   = "ObjectSize"
+)
+
+var allAttributes = []string{
+	AttributeETag,
+	AttributeChecksum,
+	AttributeObjectParts,
+	AttributeStorageClass,
+	AttributeObjectSize,
+}
+
+// ObjectAttributes are the attributes returned by Attributes. Fields that
+// were not requested are empty.
+type ObjectAttributes struct {
+	ETag         string
+	Checksum     *ObjectChecksum
+	ObjectParts  *ObjectParts
+	StorageClass StorageClass
+	ObjectSize   int64
+}
+
+// ObjectChecksum holds the additional checksums of an object or part
+type ObjectChecksum struct {
+	ChecksumCRC32  string
+	ChecksumCRC32C string
+	ChecksumSHA1   string
+	ChecksumSHA256 string
+}
+
+// ObjectParts describes the parts of an object uploaded with a multipart
+// upload. Parts are only listed if the object has additional checksums.
+type ObjectParts struct {
+	TotalPartsCount      int
+	IsTruncated          bool
+	NextPartNumberMarker int
+	Part                 []ObjectPart
+}
+
+// ObjectPart describes a part of an object
+type ObjectPart struct {
+	ObjectChecksum
+	PartNumber int
+	Size       int64
+}
+
+// Attributes returns the specified attributes of the object, or all
+// attributes if none are specified.
+//
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectAttributes.html
+func (o *object) Attributes(fields ...string) (*ObjectAttributes, error) {
+	if len(fields) == 0 {
+		fields = allAttributes
+	}
+	req, err := o.newRequest(context.Background(), "GET", "?attributes", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Amz-Object-Attributes", strings.Join(fields, ","))
+	resp, err := o.s3.send(req, 200, "error getting object attributes")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var attrs ObjectAttributes
+	if err := xml.NewDecoder(resp.Body).Decode(&attrs); err != nil {
+		return nil, err
+	}
+	attrs.ETag = strings.Trim(attrs.ETag, `"`)
+	return &attrs, nil
+}
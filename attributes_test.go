@@ -0,0 +1,50 @@
+package s3
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestAttributes(t *testing.T) {
+	s3, f := newFakeS3(t)
+
+	data := bytes.Repeat([]byte("a"), MinPartSize+10)
+	w := s3.Object("key").Writer()
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	attrs, err := s3.Object("key").Attributes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := f.lastHeader().Get("X-Amz-Object-Attributes"); x != "ETag,Checksum,ObjectParts,StorageClass,ObjectSize" {
+		t.Fatal(x)
+	}
+	if attrs.ObjectSize != int64(len(data)) || attrs.ETag != strings.Trim(f.get("/key").header.Get("ETag"), `"`) {
+		t.Fatal(attrs)
+	}
+	p := attrs.ObjectParts
+	if p == nil || p.TotalPartsCount != 2 || len(p.Part) != 2 {
+		t.Fatal(p)
+	}
+	if p.Part[0].PartNumber != 1 || p.Part[0].Size != MinPartSize || p.Part[1].Size != 10 {
+		t.Fatal(p.Part)
+	}
+
+	attrs, err = s3.Object("key").Attributes(AttributeObjectSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attrs.ObjectSize != int64(len(data)) || attrs.ETag != "" || attrs.ObjectParts != nil {
+		t.Fatal(attrs)
+	}
+
+	if _, err := s3.Object("missing").Attributes(); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,123 @@
+package s3
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// CredentialsFromEnv returns the credentials in the AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables, and the
+// region in AWS_REGION or AWS_DEFAULT_REGION, like the AWS CLI
+func CredentialsFromEnv() (creds Credentials, region string, err error) {
+	creds = Credentials{
+		AccessKey: os.Getenv("AWS_ACCESS_KEY_ID"),
+		Secret:    os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		Token:     os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if creds.AccessKey == "" || creds.Secret == "" {
+		return Credentials{}, "", errors.New("s3: AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY are not set")
+	}
+	region = os.Getenv("AWS_REGION")
+	if region == "" {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+	return creds, region, nil
+}
+
+// CredentialsFromSharedFile returns the credentials and the region of the
+// profile in the shared credentials file ~/.aws/credentials and the config
+// file ~/.aws/config, like the AWS CLI. The locations can be changed with
+// AWS_SHARED_CREDENTIALS_FILE and AWS_CONFIG_FILE. If profile is empty,
+// AWS_PROFILE or else the default profile is used.
+func CredentialsFromSharedFile(profile string) (creds Credentials, region string, err error) {
+	if profile == "" {
+		profile = os.Getenv("AWS_PROFILE")
+	}
+	if profile == "" {
+		profile = "default"
+	}
+
+	credsFile, err := awsFile("AWS_SHARED_CREDENTIALS_FILE", "credentials")
+	if err != nil {
+		return Credentials{}, "", err
+	}
+	configFile, err := awsFile("AWS_CONFIG_FILE", "config")
+	if err != nil {
+		return Credentials{}, "", err
+	}
+
+	// the config file names sections "profile <name>", except the default
+	configSection := "profile " + profile
+	if profile == "default" {
+		configSection = profile
+	}
+	config, err := readINI(configFile, configSection)
+	if err != nil {
+		return Credentials{}, "", err
+	}
+	values, err := readINI(credsFile, profile)
+	if err != nil {
+		return Credentials{}, "", err
+	}
+	// the credentials file takes precedence
+	for k, v := range values {
+		config[k] = v
+	}
+
+	creds = Credentials{
+		AccessKey: config["aws_access_key_id"],
+		Secret:    config["aws_secret_access_key"],
+		Token:     config["aws_session_token"],
+	}
+	if creds.AccessKey == "" || creds.Secret == "" {
+		return Credentials{}, "", fmt.Errorf("s3: no credentials for profile %q", profile)
+	}
+	return creds, config["region"], nil
+}
+
+// awsFile returns the path in the environment variable env, or the file
+// name in ~/.aws
+func awsFile(env, name string) (string, error) {
+	if path := os.Getenv(env); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".aws", name), nil
+}
+
+// readINI returns the keys and values of the section of the INI file at
+// path. A missing file has no values.
+func readINI(path, section string) (map[string]string, error) {
+	values := make(map[string]string)
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return values, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var current string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		switch {
+		case line == "" || line[0] == '#' || line[0] == ';':
+		case line[0] == '[' && line[len(line)-1] == ']':
+			current = strings.Join(strings.Fields(line[1:len(line)-1]), " ")
+		case current == section:
+			if i := strings.IndexByte(line, '='); i > 0 {
+				values[strings.TrimSpace(line[:i])] = strings.TrimSpace(line[i+1:])
+			}
+		}
+	}
+	return values, sc.Err()
+}
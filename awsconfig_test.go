@@ -0,0 +1,105 @@
+package s3
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCredentialsFromEnv(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AWS_SESSION_TOKEN", "token")
+	t.Setenv("AWS_REGION", "")
+	t.Setenv("AWS_DEFAULT_REGION", "eu-west-1")
+
+	creds, region, err := CredentialsFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds != (Credentials{AccessKey: "key", Secret: "secret", Token: "token"}) || region != "eu-west-1" {
+		t.Fatal(creds, region)
+	}
+
+	t.Setenv("AWS_REGION", "us-west-2")
+	if _, region, _ := CredentialsFromEnv(); region != "us-west-2" {
+		t.Fatal(region)
+	}
+
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+	if _, _, err := CredentialsFromEnv(); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestCredentialsFromSharedFile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("AWS_PROFILE", "")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", "")
+	t.Setenv("AWS_CONFIG_FILE", "")
+	os.Mkdir(filepath.Join(home, ".aws"), 0700)
+
+	credentials := `# comment
+[default]
+aws_access_key_id = defaultkey
+aws_secret_access_key = defaultsecret
+
+[work]
+aws_access_key_id=workkey
+aws_secret_access_key=worksecret
+aws_session_token = worktoken
+`
+	config := `[default]
+region = eu-west-1
+
+[profile work]
+region = us-west-2
+; comment
+
+[profile config-only]
+aws_access_key_id = configkey
+aws_secret_access_key = configsecret
+`
+	if err := os.WriteFile(filepath.Join(home, ".aws", "credentials"), []byte(credentials), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(home, ".aws", "config"), []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []struct {
+		profile string
+		env     string
+		key     string
+		token   string
+		region  string
+	}{
+		{"", "", "defaultkey", "", "eu-west-1"},
+		{"", "work", "workkey", "worktoken", "us-west-2"},
+		{"work", "", "workkey", "worktoken", "us-west-2"},
+		{"config-only", "", "configkey", "", ""},
+	} {
+		t.Setenv("AWS_PROFILE", v.env)
+		creds, region, err := CredentialsFromSharedFile(v.profile)
+		if err != nil {
+			t.Fatal(v.profile, err)
+		}
+		if creds.AccessKey != v.key || creds.Token != v.token || region != v.region {
+			t.Fatal(v.profile, creds, region)
+		}
+	}
+
+	if _, _, err := CredentialsFromSharedFile("missing"); err == nil {
+		t.Fatal("expected error")
+	}
+
+	// custom locations
+	path := filepath.Join(t.TempDir(), "creds")
+	os.WriteFile(path, []byte("[default]\naws_access_key_id=other\naws_secret_access_key=s\n"), 0600)
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", path)
+	t.Setenv("AWS_PROFILE", "")
+	if creds, _, err := CredentialsFromSharedFile(""); err != nil || creds.AccessKey != "other" {
+		t.Fatal(creds, err)
+	}
+}
@@ -0,0 +1,822 @@
+package s3
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"math/bits"
+)
+
+// brotli decoding as specified by RFC 7932. The large window extension is
+// not supported.
+//
+// https://www.rfc-editor.org/rfc/rfc7932
+
+var errBrotli = errors.New("s3: invalid brotli data")
+
+// brotliReader decompresses a brotli stream
+type brotliReader struct {
+	br  brotliBits
+	err error
+
+	// hist holds the window, followed by the decoded data from off on that
+	// wasn't read yet. total is the size of all decoded data.
+	hist   []byte
+	off    int
+	total  int
+	window int
+
+	started, done bool
+	dist          [4]int
+
+	lit, cmd, dst brotliBlocks
+}
+
+func newBrotliReader(r io.Reader) *brotliReader {
+	return &brotliReader{br: brotliBits{r: bufio.NewReader(r)}, dist: [4]int{4, 11, 15, 16}}
+}
+
+func (z *brotliReader) Read(p []byte) (int, error) {
+	for z.off == len(z.hist) {
+		if z.err != nil {
+			return 0, z.err
+		}
+		z.err = z.metaBlock()
+		if z.err == nil {
+			z.err = z.br.error()
+		}
+	}
+	n := copy(p, z.hist[z.off:])
+	z.off += n
+	return n, nil
+}
+
+func (z *brotliReader) Close() error {
+	return nil
+}
+
+// metaBlock decodes the next meta-block. It returns io.EOF after the last.
+func (z *brotliReader) metaBlock() error {
+	br := &z.br
+	if z.done {
+		return io.EOF
+	}
+	if !z.started {
+		z.started = true
+		wbits := 16
+		if br.read(1) == 1 {
+			if n := br.read(3); n != 0 {
+				wbits = 17 + int(n)
+			} else if n = br.read(3); n == 1 {
+				return errors.New("s3: brotli large windows are not supported")
+			} else if n != 0 {
+				wbits = 8 + int(n)
+			} else {
+				wbits = 17
+			}
+		}
+		z.window = 1<<wbits - 16
+	}
+
+	// keep the window only, but not on every meta-block
+	if n := len(z.hist) - z.window; n > z.window {
+		z.hist = z.hist[:copy(z.hist, z.hist[n:])]
+		z.off -= n
+	}
+
+	last := br.read(1) == 1
+	if last && br.read(1) == 1 {
+		if !br.align() {
+			return errBrotli
+		}
+		return io.EOF
+	}
+	nibbles := [4]uint{4, 5, 6, 0}[br.read(2)]
+	if nibbles == 0 {
+		// metadata
+		if last || br.read(1) != 0 {
+			return errBrotli
+		}
+		n := uint(br.read(2))
+		skip := 0
+		for i := uint(0); i < n; i++ {
+			b := int(br.read(8))
+			if i > 0 && i == n-1 && b == 0 {
+				return errBrotli
+			}
+			skip |= b << (8 * i)
+		}
+		if n > 0 {
+			skip++
+		}
+		if !br.align() {
+			return errBrotli
+		}
+		return br.skipBytes(skip)
+	}
+	size := 0
+	for i := uint(0); i < nibbles; i++ {
+		b := int(br.read(4))
+		if i > 3 && i == nibbles-1 && b == 0 {
+			return errBrotli
+		}
+		size |= b << (4 * i)
+	}
+	size++
+
+	if !last && br.read(1) == 1 {
+		// uncompressed
+		if !br.align() {
+			return errBrotli
+		}
+		start := len(z.hist)
+		z.hist = grow(z.hist, size)
+		if err := br.readBytes(z.hist[start:]); err != nil {
+			return err
+		}
+		z.total += size
+		return nil
+	}
+
+	if err := z.compressed(size); err != nil {
+		return err
+	}
+	if last {
+		if err := br.error(); err != nil {
+			return err
+		}
+		if !br.align() {
+			return errBrotli
+		}
+		z.done = true
+	}
+	return nil
+}
+
+// compressed decodes a compressed meta-block of size bytes
+func (z *brotliReader) compressed(size int) error {
+	br := &z.br
+	for _, b := range []*brotliBlocks{&z.lit, &z.cmd, &z.dst} {
+		if err := z.readBlocks(b); err != nil {
+			return err
+		}
+	}
+	postfix := br.read(2)
+	direct := int(br.read(4)) << postfix
+	modes := make([]byte, z.lit.n)
+	for i := range modes {
+		modes[i] = byte(br.read(2))
+	}
+	litTrees := z.varLenUint8() + 1
+	litMap, err := z.readContextMap(64*z.lit.n, litTrees)
+	if err != nil {
+		return err
+	}
+	dstTrees := z.varLenUint8() + 1
+	dstMap, err := z.readContextMap(4*z.dst.n, dstTrees)
+	if err != nil {
+		return err
+	}
+	litCodes, err := z.readCodes(litTrees, 256)
+	if err != nil {
+		return err
+	}
+	cmdCodes, err := z.readCodes(z.cmd.n, 704)
+	if err != nil {
+		return err
+	}
+	dstCodes, err := z.readCodes(dstTrees, 16+direct+48<<postfix)
+	if err != nil {
+		return err
+	}
+
+	for size > 0 {
+		if err := br.error(); err != nil {
+			return err
+		}
+		z.switchBlock(&z.cmd)
+		c := br.decode(cmdCodes[z.cmd.typ])
+		cell := brotliCells[c>>6]
+		ic, cc := cell[0]+c>>3&7, cell[1]+c&7
+		insert := int(brotliInsertBase[ic] + br.read(brotliInsertBits[ic]))
+		n := int(brotliCopyBase[cc] + br.read(brotliCopyBits[cc]))
+
+		if insert > size {
+			return errBrotli
+		}
+		for i := 0; i < insert; i++ {
+			z.switchBlock(&z.lit)
+			var p1, p2 byte
+			if k := len(z.hist); k > 1 {
+				p1, p2 = z.hist[k-1], z.hist[k-2]
+			} else if k == 1 {
+				p1 = z.hist[0]
+			}
+			ctx := brotliContext(modes[z.lit.typ], p1, p2)
+			z.hist = append(z.hist, byte(br.decode(litCodes[litMap[64*z.lit.typ+ctx]])))
+		}
+		z.total += insert
+		size -= insert
+		if size == 0 {
+			break
+		}
+
+		// distance
+		d, push := z.dist[0], false
+		if cell[2] == 0 {
+			z.switchBlock(&z.dst)
+			ctx := 3
+			if n < 5 {
+				ctx = n - 2
+			}
+			code := br.decode(dstCodes[dstMap[4*z.dst.typ+ctx]])
+			switch {
+			case code < 16:
+				d = z.dist[brotliDistRing[code]] + int(brotliDistDelta[code])
+				push = code > 0
+				if d <= 0 {
+					return errBrotli
+				}
+			case code < 16+direct:
+				d, push = code-15, true
+			default:
+				code -= 16 + direct
+				nbits := 1 + uint(code)>>(postfix+1)
+				offset := (2+code>>postfix&1)<<nbits - 4
+				d = (offset+int(br.read(nbits)))<<postfix + code&(1<<postfix-1) + direct + 1
+				push = true
+			}
+		}
+
+		max := z.window
+		if z.total < max {
+			max = z.total
+		}
+		if d > max {
+			// a static dictionary word
+			if n < 4 || n > 24 {
+				return errBrotli
+			}
+			nbits := brotliDictBits[n]
+			word := d - max - 1
+			t := word >> nbits
+			if t >= len(brotliTransforms) {
+				return errBrotli
+			}
+			start := brotliDictOffsets[n] + word&(1<<nbits-1)*n
+			before := len(z.hist)
+			z.hist = brotliTransform(z.hist, brotliDict()[start:start+n], t)
+			if n = len(z.hist) - before; n > size {
+				return errBrotli
+			}
+		} else {
+			if push {
+				z.dist = [4]int{d, z.dist[0], z.dist[1], z.dist[2]}
+			}
+			if n > size {
+				return errBrotli
+			}
+			z.hist = appendMatch(z.hist, d, n)
+		}
+		z.total += n
+		size -= n
+	}
+	return nil
+}
+
+// brotliBlocks is the block switching state of a block category
+type brotliBlocks struct {
+	n         int
+	types     brotliCode
+	counts    brotliCode
+	typ, prev int
+	left      int
+}
+
+// readBlocks reads the block types and the first block count of a
+// category
+func (z *brotliReader) readBlocks(b *brotliBlocks) error {
+	*b = brotliBlocks{n: z.varLenUint8() + 1, prev: 1, left: 1 << 30}
+	if b.n == 1 {
+		return nil
+	}
+	var err error
+	if b.types, err = z.readCode(b.n + 2); err != nil {
+		return err
+	}
+	if b.counts, err = z.readCode(26); err != nil {
+		return err
+	}
+	b.left = z.blockCount(b)
+	return nil
+}
+
+// switchBlock switches to the next block at the end of the current one
+// and counts an item of the block
+func (z *brotliReader) switchBlock(b *brotliBlocks) {
+	if b.left == 0 {
+		t := z.br.decode(b.types)
+		switch t {
+		case 0:
+			t = b.prev
+		case 1:
+			t = (b.typ + 1) % b.n
+		default:
+			t -= 2
+		}
+		b.prev, b.typ = b.typ, t
+		b.left = z.blockCount(b)
+	}
+	b.left--
+}
+
+func (z *brotliReader) blockCount(b *brotliBlocks) int {
+	c := z.br.decode(b.counts)
+	return int(brotliBlockBase[c] + z.br.read(brotliBlockBits[c]))
+}
+
+// varLenUint8 reads a number from 0 to 255
+func (z *brotliReader) varLenUint8() int {
+	if z.br.read(1) == 0 {
+		return 0
+	}
+	n := uint(z.br.read(3))
+	if n == 0 {
+		return 1
+	}
+	return 1<<n + int(z.br.read(n))
+}
+
+// readContextMap reads a context map of size entries
+func (z *brotliReader) readContextMap(size, trees int) ([]byte, error) {
+	m := make([]byte, size)
+	if trees == 1 {
+		return m, nil
+	}
+	br := &z.br
+	rle := 0
+	if br.read(1) == 1 {
+		rle = int(br.read(4)) + 1
+	}
+	code, err := z.readCode(trees + rle)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < size; {
+		if err := br.error(); err != nil {
+			return nil, err
+		}
+		c := br.decode(code)
+		switch {
+		case c == 0:
+			i++
+		case c <= rle:
+			i += 1<<c + int(br.read(uint(c)))
+			if i > size {
+				return nil, errBrotli
+			}
+		default:
+			m[i] = byte(c - rle)
+			i++
+		}
+	}
+	if br.read(1) == 1 {
+		// inverse move-to-front transform
+		var mtf [256]byte
+		for i := range mtf {
+			mtf[i] = byte(i)
+		}
+		for i, c := range m {
+			v := mtf[c]
+			m[i] = v
+			copy(mtf[1:int(c)+1], mtf[:c])
+			mtf[0] = v
+		}
+	}
+	return m, nil
+}
+
+// readCodes reads n prefix codes
+func (z *brotliReader) readCodes(n, alphabet int) ([]brotliCode, error) {
+	codes := make([]brotliCode, n)
+	for i := range codes {
+		var err error
+		if codes[i], err = z.readCode(alphabet); err != nil {
+			return nil, err
+		}
+	}
+	return codes, nil
+}
+
+var (
+	brotliCodeLengthOrder = [18]int{1, 2, 3, 4, 0, 5, 17, 6, 16, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+	// the code of the code lengths of the code length code, by the next
+	// 4 bits
+	brotliCodeLengthBits  = [16]uint{2, 2, 2, 3, 2, 2, 2, 4, 2, 2, 2, 3, 2, 2, 2, 4}
+	brotliCodeLengthValue = [16]uint8{0, 4, 3, 2, 0, 4, 3, 1, 0, 4, 3, 2, 0, 4, 3, 5}
+)
+
+// readCode reads a prefix code of the alphabet size
+func (z *brotliReader) readCode(alphabet int) (brotliCode, error) {
+	br := &z.br
+	lengths := make([]uint8, alphabet)
+	skip := br.read(2)
+	if skip == 1 {
+		// simple prefix code
+		nsym := int(br.read(2)) + 1
+		nbits := uint(bits.Len(uint(alphabet - 1)))
+		syms := make([]int, nsym)
+		for i := range syms {
+			syms[i] = int(br.read(nbits))
+			if syms[i] >= alphabet {
+				return nil, errBrotli
+			}
+			for _, s := range syms[:i] {
+				if s == syms[i] {
+					return nil, errBrotli
+				}
+			}
+		}
+		var l []uint8
+		switch nsym {
+		case 1:
+			return brotliSingleCode(syms[0]), nil
+		case 2:
+			l = []uint8{1, 1}
+		case 3:
+			l = []uint8{1, 2, 2}
+		case 4:
+			l = []uint8{2, 2, 2, 2}
+			if br.read(1) == 1 {
+				l = []uint8{1, 2, 3, 3}
+			}
+		}
+		for i, s := range syms {
+			lengths[s] = l[i]
+		}
+		return newBrotliCode(lengths), nil
+	}
+
+	// complex prefix code, whose code lengths are prefix coded
+	var clens [18]uint8
+	space, n := 32, 0
+	for _, s := range brotliCodeLengthOrder[skip:] {
+		v := br.peek(4)
+		br.skip(brotliCodeLengthBits[v])
+		l := brotliCodeLengthValue[v]
+		clens[s] = l
+		if l != 0 {
+			space -= 32 >> l
+			n++
+			if space <= 0 {
+				break
+			}
+		}
+	}
+	if n != 1 && space != 0 {
+		return nil, errBrotli
+	}
+	var clcode brotliCode
+	if n == 1 {
+		for s, l := range clens {
+			if l != 0 {
+				clcode = brotliSingleCode(s)
+			}
+		}
+	} else {
+		clcode = newBrotliCode(clens[:])
+	}
+
+	var (
+		prev      uint8 = 8
+		repeat    int
+		repeatLen uint8
+	)
+	space = 32768
+	for s := 0; s < alphabet && space > 0; {
+		if err := br.error(); err != nil {
+			return nil, err
+		}
+		c := br.decode(clcode)
+		if c < 16 {
+			repeat = 0
+			lengths[s] = uint8(c)
+			s++
+			if c != 0 {
+				prev = uint8(c)
+				space -= 32768 >> c
+			}
+			continue
+		}
+		extra, l := uint(3), uint8(0)
+		if c == 16 {
+			extra, l = 2, prev
+		}
+		if repeatLen != l {
+			repeat, repeatLen = 0, l
+		}
+		old := repeat
+		if repeat > 0 {
+			repeat = (repeat - 2) << extra
+		}
+		repeat += int(br.read(extra)) + 3
+		delta := repeat - old
+		if s+delta > alphabet {
+			return nil, errBrotli
+		}
+		for i := 0; i < delta; i++ {
+			lengths[s+i] = l
+		}
+		s += delta
+		if l != 0 {
+			space -= delta << (15 - l)
+		}
+	}
+	if space != 0 {
+		return nil, errBrotli
+	}
+	return newBrotliCode(lengths), nil
+}
+
+const brotliRootBits = 8
+
+// brotliCode is a prefix code decoding table indexed by the next root bits.
+// Longer codes continue in second level tables at the end.
+type brotliCode []brotliEntry
+
+// brotliEntry is a symbol and its code length, or the offset and index
+// bits of a second level table
+type brotliEntry struct {
+	sym  uint16
+	bits uint8
+	link bool
+}
+
+// brotliSingleCode returns the code of a single symbol, which takes no
+// bits
+func brotliSingleCode(sym int) brotliCode {
+	c := make(brotliCode, 1<<brotliRootBits)
+	for i := range c {
+		c[i] = brotliEntry{sym: uint16(sym)}
+	}
+	return c
+}
+
+// newBrotliCode returns the canonical prefix code of the code lengths,
+// which form a complete code
+func newBrotliCode(lengths []uint8) brotliCode {
+	var count, next [16]int
+	for _, l := range lengths {
+		count[l]++
+	}
+	count[0] = 0
+	code := 0
+	for l := 1; l < 16; l++ {
+		code = (code + count[l-1]) << 1
+		next[l] = code
+	}
+	// the codes are read from the most significant bit, which is the first
+	// bit of the stream
+	codes := make([]int, len(lengths))
+	var sub [1 << brotliRootBits]uint8
+	for s, l := range lengths {
+		if l == 0 {
+			continue
+		}
+		codes[s] = int(bits.Reverse16(uint16(next[l])) >> (16 - l))
+		next[l]++
+		if l > brotliRootBits {
+			i := codes[s] & (1<<brotliRootBits - 1)
+			if n := l - brotliRootBits; n > sub[i] {
+				sub[i] = n
+			}
+		}
+	}
+
+	c := make(brotliCode, 1<<brotliRootBits)
+	for i, n := range sub {
+		if n > 0 {
+			c[i] = brotliEntry{sym: uint16(len(c)), bits: n, link: true}
+			c = append(c, make(brotliCode, 1<<n)...)
+		}
+	}
+	for s, l := range lengths {
+		if l == 0 {
+			continue
+		}
+		e := brotliEntry{sym: uint16(s), bits: l}
+		t, i := c[:1<<brotliRootBits], codes[s]
+		if l > brotliRootBits {
+			link := c[i&(1<<brotliRootBits-1)]
+			t = c[link.sym : int(link.sym)+1<<link.bits]
+			i >>= brotliRootBits
+			l -= brotliRootBits
+		}
+		for ; i < len(t); i += 1 << l {
+			t[i] = e
+		}
+	}
+	return c
+}
+
+// brotliContext returns the literal context id of the last two bytes
+func brotliContext(mode, p1, p2 byte) int {
+	switch mode {
+	case 0:
+		return int(p1 & 0x3F)
+	case 1:
+		return int(p1 >> 2)
+	case 2:
+		return int(brotliLUT0[p1] | brotliLUT1[p2])
+	default:
+		return int(brotliLUT2[p1]<<3 | brotliLUT2[p2])
+	}
+}
+
+// brotliTransform appends the word transformed by the transform t to b
+func brotliTransform(b, word []byte, t int) []byte {
+	tr := brotliTransforms[t]
+	b = append(b, tr.prefix...)
+	start := len(b)
+	switch typ := int(tr.typ); {
+	case typ <= 9:
+		if typ > len(word) {
+			typ = len(word)
+		}
+		b = append(b, word[:len(word)-typ]...)
+	case typ <= 11:
+		// uppercasing may change the bytes after the last letter, which
+		// are dropped
+		b = append(b, word...)
+		end := len(b)
+		b = append(b, 0, 0)
+		for i := start; i < end; {
+			i += brotliUpper(b[i:])
+			if typ == 10 {
+				break
+			}
+		}
+		b = b[:end]
+	default:
+		n := typ - 11
+		if n > len(word) {
+			n = len(word)
+		}
+		b = append(b, word[n:]...)
+	}
+	return append(b, tr.suffix...)
+}
+
+// brotliUpper uppercases the UTF-8 character at the start of p the way
+// brotli does and returns its length
+func brotliUpper(p []byte) int {
+	switch {
+	case p[0] < 0xC0:
+		if p[0] >= 'a' && p[0] <= 'z' {
+			p[0] ^= 32
+		}
+		return 1
+	case p[0] < 0xE0:
+		p[1] ^= 32
+		return 2
+	default:
+		p[2] ^= 5
+		return 3
+	}
+}
+
+var (
+	// brotliCells are the insert and copy length code offsets of the
+	// cells of 64 insert-and-copy codes, and whether the distance is read
+	// (0) or the last distance is used (1)
+	brotliCells = [11][3]int{
+		{0, 0, 1}, {0, 8, 1}, {0, 0, 0}, {0, 8, 0}, {8, 0, 0}, {8, 8, 0},
+		{0, 16, 0}, {16, 0, 0}, {8, 16, 0}, {16, 8, 0}, {16, 16, 0},
+	}
+
+	brotliInsertBase = [24]uint32{
+		0, 1, 2, 3, 4, 5, 6, 8, 10, 14, 18, 26, 34, 50, 66, 98,
+		130, 194, 322, 578, 1090, 2114, 6210, 22594,
+	}
+	brotliInsertBits = [24]uint{
+		0, 0, 0, 0, 0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 5, 5,
+		6, 7, 8, 9, 10, 12, 14, 24,
+	}
+	brotliCopyBase = [24]uint32{
+		2, 3, 4, 5, 6, 7, 8, 9, 10, 12, 14, 18, 22, 30, 38, 54,
+		70, 102, 134, 198, 326, 582, 1094, 2118,
+	}
+	brotliCopyBits = [24]uint{
+		0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 2, 2, 3, 3, 4, 4,
+		5, 5, 6, 7, 8, 9, 10, 24,
+	}
+
+	brotliBlockBase = [26]uint32{
+		1, 5, 9, 13, 17, 25, 33, 41, 49, 65, 81, 97, 113, 145, 177, 209,
+		241, 305, 369, 497, 753, 1265, 2289, 4337, 8433, 16625,
+	}
+	brotliBlockBits = [26]uint{
+		2, 2, 2, 2, 3, 3, 3, 3, 4, 4, 4, 4, 5, 5, 5, 5,
+		6, 6, 7, 8, 9, 10, 11, 12, 13, 24,
+	}
+
+	// the short distance codes are the last distances with index
+	// brotliDistRing, plus brotliDistDelta
+	brotliDistRing  = [16]int{0, 1, 2, 3, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 1, 1}
+	brotliDistDelta = [16]int8{0, 0, 0, 0, -1, 1, -2, 2, -3, 3, -1, 1, -2, 2, -3, 3}
+)
+
+// brotliBits reads bits from the start of the stream, least significant
+// bits first. Past the end of the stream it reads zeros, which error
+// reports.
+type brotliBits struct {
+	r   *bufio.Reader
+	err error
+
+	// acc holds n bits, of which the last pad bits are after the end
+	acc    uint64
+	n, pad uint
+}
+
+func (b *brotliBits) fill(n uint) {
+	for b.n < n {
+		c, err := b.r.ReadByte()
+		if err != nil {
+			if err != io.EOF && b.err == nil {
+				b.err = err
+			}
+			b.pad += 8
+		}
+		b.acc |= uint64(c) << b.n
+		b.n += 8
+	}
+}
+
+func (b *brotliBits) peek(n uint) uint32 {
+	b.fill(n)
+	return uint32(b.acc & (1<<n - 1))
+}
+
+func (b *brotliBits) skip(n uint) {
+	b.acc >>= n
+	b.n -= n
+}
+
+func (b *brotliBits) read(n uint) uint32 {
+	v := b.peek(n)
+	b.skip(n)
+	return v
+}
+
+// decode reads a symbol of the prefix code
+func (b *brotliBits) decode(c brotliCode) int {
+	e := c[b.peek(15)&(1<<brotliRootBits-1)]
+	if e.link {
+		e = c[int(e.sym)+int(b.acc>>brotliRootBits&(1<<e.bits-1))]
+	}
+	b.skip(uint(e.bits))
+	return int(e.sym)
+}
+
+// align skips to the next byte and reports whether the skipped bits are 0
+func (b *brotliBits) align() bool {
+	return b.read(b.n%8) == 0
+}
+
+// readBytes reads len(p) bytes at a byte boundary
+func (b *brotliBits) readBytes(p []byte) error {
+	for len(p) > 0 && b.n >= 8 {
+		p[0] = byte(b.read(8))
+		p = p[1:]
+	}
+	if err := b.error(); err != nil {
+		return err
+	}
+	_, err := io.ReadFull(b.r, p)
+	return noEOF(err)
+}
+
+// skipBytes skips n bytes at a byte boundary
+func (b *brotliBits) skipBytes(n int) error {
+	for ; n > 0 && b.n >= 8; n-- {
+		b.read(8)
+	}
+	if err := b.error(); err != nil {
+		return err
+	}
+	_, err := b.r.Discard(n)
+	return noEOF(err)
+}
+
+// error returns the read error, or io.ErrUnexpectedEOF if bits past the
+// end were read
+func (b *brotliBits) error() error {
+	if b.err != nil {
+		return b.err
+	}
+	if b.n < b.pad {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
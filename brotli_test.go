@@ -0,0 +1,47 @@
+package s3
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+// brotliLines is testLines compressed with brotli at quality 11
+const brotliLines = `
+GyI/AJwJNk6odzc8Zw0W6w8YDFv2TOYZnl6FvTWIwlxe/pE/eAWw4MFr0AIW5hOd/9qbBaNT
+lzJ4NN5mGRTY4uNXdfh5n9+O/N4e/f3Nev7MbP/+fd96b3qmNd3tsWmsprWqLX1zPu8Zx3CJ
+u7rOgeVrfc0JaU+u97hGn/JFdZwbq+M8qD7Vp8n7nvZsI1gvKoffjp9uzxlkmFemlftx/cR6
+7vCFZp9TzwcgFZ/l8+aff34eevB5fJYD4+JavsfD/b5Aj3eefLmeixl/xxnty/cNp3VYd7C+
+Vt3eU0qW8eF6unNfifbj0Fuv0Wkd4b4v+LgbvTwe3se9WvgPc1rmb/Vd7bA/v8+Tapyars2p
+D7fO5xDPmdd4tcz3uMsPuqk7Wh+OfW4ZHT9+b58b6HHpbh6cNms8rkvgPl7UE9XC3DtvbfMz
+Hk7w63s9fKCzdfTW5106rXdff+DrkZq52pu6vBpQva916v0754+XB9zhjrbvz6mj49S5PLcf
+R613dTC/8A1gf83f8dX1PtEruaKjfT4/cobn+XfjO38cpzPrU9U2z/zCAXYcPKmH4er81QDr
+vbvvLuvHo3s6qqWeHxwy/268PNB+dZT94SllnPPlBM/Ug1cvZr45iXXWI3yHGzyry/rm1SBq
+L98nMfkT53BTVxw8t5f2/C2Xxi8XR8c14TrZdcDWfVKP3vk29rqoF4/39rUdjM/pvvo8NRl+
+U6OokznHLjxv2nWGNd7Wru+nKDjj2b/MN27ep8sab/K843icwxVu6EN9b3JiuU7HdR9zcbVd
+78Nzyf5G+HTdD/x5fN8T9e2r9VR3k+/s57ulp6ZvPw40R3mtfe+1deCzuqyTV3PBy/Ud1PNy
+76oxj1sn+8r9eiS0Dw==`
+
+func TestBrotliReader(t *testing.T) {
+	lines := unbase64(t, brotliLines)
+	for _, tt := range []struct {
+		name string
+		data []byte
+		want string
+		err  error
+	}{
+		{"lines", lines, testLines(), nil},
+		// words of the static dictionary, some transformed
+		{"dictionary", unbase64(t, "G1cAEKRBqMrQgEMO2F9bSy3C3OA8DyMooyRefw8q8GdIkpOJRTQ9gzxwGAQ="),
+			"The information for the people of the world, and the time that they have in their life. ", nil},
+		{"empty", []byte{0x06}, "", nil},
+		{"truncated", lines[:len(lines)/2], "", io.ErrUnexpectedEOF},
+		{"padding", []byte{0x16}, "", errBrotli},
+	} {
+		data, err := ioutil.ReadAll(newBrotliReader(bytes.NewReader(tt.data)))
+		if err != tt.err || tt.err == nil && string(data) != tt.want {
+			t.Errorf("%s: %q %v", tt.name, data, err)
+		}
+	}
+}
@@ -0,0 +1,1258 @@
+package s3
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/base64"
+	"io/ioutil"
+	"sync"
+)
+
+// The brotli static dictionary, word transforms and context lookup tables
+// of RFC 7932, appendices A and B and section 7.1.
+
+var (
+	brotliDictOnce sync.Once
+	brotliDictData []byte
+)
+
+// brotliDict returns the static dictionary, which is stored deflated
+func brotliDict() []byte {
+	brotliDictOnce.Do(func() {
+		b, err := base64.StdEncoding.DecodeString(brotliDictDeflated)
+		if err == nil {
+			brotliDictData, err = ioutil.ReadAll(flate.NewReader(bytes.NewReader(b)))
+		}
+		if err != nil {
+			panic(err)
+		}
+	})
+	return brotliDictData
+}
+
+// the word lengths 4 to 24 of the dictionary have 1<<brotliDictBits words
+var brotliDictBits = [25]uint{
+	0, 0, 0, 0, 10, 10, 11, 11, 10, 10, 10, 10, 10, 9, 9, 8, 7, 7, 8, 7, 7, 6, 6, 5, 5,
+}
+
+// brotliDictOffsets are the offsets of the words by length
+var brotliDictOffsets = func() (o [25]int) {
+	for n := 4; n < 24; n++ {
+		o[n+1] = o[n] + n<<brotliDictBits[n]
+	}
+	return o
+}()
+
+// brotliTransforms are the word transforms, with the types identity (0),
+// omit the last 1-9 bytes (1-9), uppercase the first (10) and all (11)
+// letters, and omit the first 1-9 bytes (12-20)
+var brotliTransforms = [121]struct {
+	prefix string
+	typ    uint8
+	suffix string
+}{
+	{"", 0, ""},
+	{"", 0, " "},
+	{" ", 0, " "},
+	{"", 12, ""},
+	{"", 10, " "},
+	{"", 0, " the "},
+	{" ", 0, ""},
+	{"s ", 0, " "},
+	{"", 0, " of "},
+	{"", 10, ""},
+	{"", 0, " and "},
+	{"", 13, ""},
+	{"", 1, ""},
+	{", ", 0, " "},
+	{"", 0, ", "},
+	{" ", 10, " "},
+	{"", 0, " in "},
+	{"", 0, " to "},
+	{"e ", 0, " "},
+	{"", 0, "\""},
+	{"", 0, "."},
+	{"", 0, "\">"},
+	{"", 0, "\n"},
+	{"", 3, ""},
+	{"", 0, "]"},
+	{"", 0, " for "},
+	{"", 14, ""},
+	{"", 2, ""},
+	{"", 0, " a "},
+	{"", 0, " that "},
+	{" ", 10, ""},
+	{"", 0, ". "},
+	{".", 0, ""},
+	{" ", 0, ", "},
+	{"", 15, ""},
+	{"", 0, " with "},
+	{"", 0, "'"},
+	{"", 0, " from "},
+	{"", 0, " by "},
+	{"", 16, ""},
+	{"", 17, ""},
+	{" the ", 0, ""},
+	{"", 4, ""},
+	{"", 0, ". The "},
+	{"", 11, ""},
+	{"", 0, " on "},
+	{"", 0, " as "},
+	{"", 0, " is "},
+	{"", 7, ""},
+	{"", 1, "ing "},
+	{"", 0, "\n\t"},
+	{"", 0, ":"},
+	{" ", 0, ". "},
+	{"", 0, "ed "},
+	{"", 20, ""},
+	{"", 18, ""},
+	{"", 6, ""},
+	{"", 0, "("},
+	{"", 10, ", "},
+	{"", 8, ""},
+	{"", 0, " at "},
+	{"", 0, "ly "},
+	{" the ", 0, " of "},
+	{"", 5, ""},
+	{"", 9, ""},
+	{" ", 10, ", "},
+	{"", 10, "\""},
+	{".", 0, "("},
+	{"", 11, " "},
+	{"", 10, "\">"},
+	{"", 0, "=\""},
+	{" ", 0, "."},
+	{".com/", 0, ""},
+	{" the ", 0, " of the "},
+	{"", 10, "'"},
+	{"", 0, ". This "},
+	{"", 0, ","},
+	{".", 0, " "},
+	{"", 10, "("},
+	{"", 10, "."},
+	{"", 0, " not "},
+	{" ", 0, "=\""},
+	{"", 0, "er "},
+	{" ", 11, " "},
+	{"", 0, "al "},
+	{" ", 11, ""},
+	{"", 0, "='"},
+	{"", 11, "\""},
+	{"", 10, ". "},
+	{" ", 0, "("},
+	{"", 0, "ful "},
+	{" ", 10, ". "},
+	{"", 0, "ive "},
+	{"", 0, "less "},
+	{"", 11, "'"},
+	{"", 0, "est "},
+	{" ", 10, "."},
+	{"", 11, "\">"},
+	{" ", 0, "='"},
+	{"", 10, ","},
+	{"", 0, "ize "},
+	{"", 11, "."},
+	{"\u00a0", 0, ""},
+	{" ", 0, ","},
+	{"", 10, "=\""},
+	{"", 11, "=\""},
+	{"", 0, "ous "},
+	{"", 11, ", "},
+	{"", 10, "='"},
+	{" ", 10, ","},
+	{" ", 11, "=\""},
+	{" ", 11, ", "},
+	{"", 11, ","},
+	{"", 11, "("},
+	{"", 11, ". "},
+	{" ", 11, "."},
+	{"", 11, "='"},
+	{" ", 11, ". "},
+	{" ", 10, "=\""},
+	{" ", 11, "='"},
+	{" ", 10, "='"},
+}
+
+// brotliLUT0 and brotliLUT1 give the UTF8 context of the last two bytes
+var brotliLUT0 = [256]uint8{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 0, 4, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	8, 12, 16, 12, 12, 20, 12, 16, 24, 28, 12, 12, 32, 12, 36, 12,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 32, 32, 24, 40, 28, 12,
+	12, 48, 52, 52, 52, 48, 52, 52, 52, 48, 52, 52, 52, 52, 52, 48,
+	52, 52, 52, 52, 52, 48, 52, 52, 52, 52, 52, 24, 12, 28, 12, 12,
+	12, 56, 60, 60, 60, 56, 60, 60, 60, 56, 60, 60, 60, 60, 60, 56,
+	60, 60, 60, 60, 60, 56, 60, 60, 60, 60, 60, 24, 12, 28, 12, 0,
+	0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+	0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+	0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+	0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+}
+
+var brotliLUT1 = [256]uint8{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1,
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 1, 1, 1, 1, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+}
+
+// brotliLUT2 gives the signed context of a byte
+var brotliLUT2 = [256]uint8{
+	0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+	5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+	5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+	6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 7,
+}
+
+// brotliDictDeflated is the deflated dictionary, base64 encoded
+const brotliDictDeflated = `
+PL3pchzHtS7624jgO5TaZ4vENtEASU0mBgdHSd4auAXKvts+DkV2VXZ3AdVVrcoqgE1JEeCAgeAA
+UpwFzjMlAiA4YiCBiMMXoP6R/xTnorobEfch7vethGwZEtCdlZXDGr61cq2ViV/RXjQYBn5RB7qY
+FJTb70ae9lSiTDkajMKgZvxEu35Si6o67EtNEvj9uhhrPRjF/Ynel9S0iqMBHRcirxbgl2IUVwpR
+1F8NVC3wB3Tgh7qsg2o5qmjje7oSxXzWC6KwlJR1ZcDXg0U/9KqqhPfWTDENgrJWXqLjilZuWcVa
+FeOoksSprqi4XxUCnVajsOyXyhinDlTohXrQ6AEdhhiPq4wuREm5GpkkNdqrKE+X0aas8d6ySkJV
+0Z/5YX8hiErG368LaI/xoV0/+vdDPBOvb3X+VI4CT4eeGfST8hfoH8PwBtGH8UthgrZlNaBL6Mto
+HboqCKoqKQ9q/DdITUWHadEPKlUVJ32RHyZl3wS+SUpRhLFqbxDzHNQG3xVMBXNXgYkwnCj23TLe
+bwJlkkQrTLdSK+KZfj8sDfpBoPH5oIq9Ap4t+rHegzXrD6NBhQ7z1bBUwfonmGwQKa+EtTc6KIZR
+oiupWy5qrkVYiyO333ejMApdHWCfytgTz9feJ9ifOA10Geum+tQ+PyxGbpAWAjVoAm1MWQVFwz1E
+X/ujUG/q6PgP/Me42KC9oKNYuboQpNj/NB7Uur+Iv8sgmhLWCZuI/cbYyzrEmvT362pSVQad+tU4
+iiqf7P38M9BLuLdWBT2iUzzTr3W1GKgS6Kcfq+MVMZ8E442x5wloti+tVBOslof+MAZ8DwoGLWL0
+tX6sFdYhKWJfVJpEoI0474KGVNAPuq4OYE089F8BrWNHkySNw0KEH9AJOCGII6PTONhgsPBxFGg8
+ClJMDJYbe6uxj4GX76uWwBqVARXX8EwCWg4NCMaLo+rfQNNuVK1tynfkutpVj0mwEAF2w8fLsD5V
+8Fm+5BexBolrzF9KsarhDeG7paQzxn5gjEmAeRrlozvl4R0O9i/oi8ohaKS/GsWYm0nWf72tzQNd
+drUXeqpRFBc4b6zh1/ne/CDopgJ+3Vzd1/kp9jJWYf8g+h5UIWgoIDF5/+z4V2dVpcEg1tuksf5f
+G9b/cVD5SQV7A9ozpUibEngCW1OqYizvtLU5AegnDf0E+8Z++kGHlUHIj32VIGfI05ji5o7qPiwd
+xj6I+UZBMQrRpx8YVcS36FlVjQs5EKPvYhANFlShZqoqNOCL9zDeD/AD1jGg/QR8Fwz6/T5kQmIS
+EE7sY/ql9gA8OKhVf4K92A2ZA77HmoQGe9iP7a7FaWj60qAGSda/Cf2VIhWUIAIM3qe9kva97pzR
+iXkf3+X7zF/ew5j9orPBRBH2EXICdJikBb1fkxpCjEN7oOkE3Bxh60BecWXTR9V9LmRAGetewDj2
+g5YHIiyyMrUYPAuaDKoYo4/1ckH/W/CuPux9okqm4CcGtBWA+ZJ+CLAQtNuFBS5h0f7a++UXXprU
+vqB8UYGuRSnWPcES+WGf2r/fpWyqaVP0TXlwcDAf+wZ9Fkw11gObMA/8rTdjYtvBjx7GsaWjY2MB
+ew4qwNjiELLEK0T78kXlxwHGg/Vi33Ffijlrt+wXN7xTBZ3pAT/4XxtyoIm4EuBZD/RQhXze2NHR
+wVl6sRosp2CKapQU09ArgI/KsS5CsgT9umYS9FEGvYP3Idd1sAnEaFI/waPBV729OYp87Ent4117
+c1gzrXzQHIfkewZL2xmqgVoJDJH4VfPHP//5zxgHZKNXA7+b1k7nh2q5+hfI7gB8HQxCwewvb83+
+s31diwPhBHkV5Ftatn2IfqqpKUOTJFiaThc08J/tXe1laLgPsUaQMUkMGea8+64DbRJgP6FdQFUY
+RMWHjOr3qwn2HzoK+iHQJdBMFZvxHtZ0Xcu6Fpf0DZmQx2C6CnGPBoNWsNel1IecDrBRBq8GkyhK
+pKjS7ldK7/7xo81BCl2LfrGUnSCqWgn0Vk4qlBKBF6el3V/t2hVCPoVYw7+AWANSIdZ+gDoAvIX1
+c0D/cayDWgE6yIBWvLRfh8ooV1X1IGijlAbFvZu3bC2D1kD3SQkyBcIfui+NIa9qmzD/9etbO1s7
+cz0VA6Hlg3/82DOQLwUNzoEc37vpo63YekMGwPyDD7CIRdDD/x26aApRzUCG5NFFbs+XvXsLGE8/
+9q6184cfoLxqWDdvw9f/1fptqjzsTb0NCrcN/4PQr+KZdcAdyd5Nf9464LuQfl7N04WkBxODLAtC
+zXFGg3s3fbDVg2zTAyrYu+nDrQHWH3QLclRBFfwTRgMK/GBKOtTO/x06Y6IiZFNFJ77IcdNV3gLZ
+mMZF4IAqdElXEvdA5/qt37+zAf/VGFezAHqCfIk7Olo7K37Q77S19fhxFALneB54axD7ZCI/qKaJ
+ae8z7cAGtb2bN2/9tHf7F3s3d2xVnqpgrfCuzT19EILrNzrroWKTvZs3bXUwH+hZiHpfVSBbutqr
+PSZKg88+/WIX+BF4JcYavLe1q7yp5yOsLURA159Bp3s73ttaAdba+t4H/wANaD90y/8DzAXy4phX
+1re2gq5j7ElJQ6eCtELgntDbuuXDf3wTFb9Z/6/WTtDWRrRtJJCJNeKGaDAogBe2vv/BP/6qBtQW
+vK/l+x9a/mPHlv/YuuW9f/TpYnHX/7PnU8jS8oAPdQfSNsAX6GP125Q6FPwA+oGuSrB2+QLo0AO9
+loFFIC+AkcJk65YP/vHJ3r172jZ3bCpG0NToY9cXO519EDhb33/vH9u/3Pk/HvrobPmhRe/z0f79
+f0C/GdBOsv6H1k5gkQSs3qmA5n74oav9n/6/8p9B//RX/s+jQbwnAc0pzzOQFgHYcV9nyx9+gMiK
+QuxlHoqho+P9VqxFrQ+i3oCowLGQs1ERa9HqbO7oAI2Dh7EYgH/AqeCJuOZiX5MaKEsDU/hRmIcy
+c6HnSngvdGL+fazTnnJ+J+QBoJ7gqxB83Q9agaZRIfThPtCJC7pv8aBz3cCvtkL4fIjnsA4dO/+2
+03z4r56uBHxJBOz/6U+tg9g3gIyoCuURVROo/EDv3vbfBnQStm3+YG9AeQ5a//qrz5xCGgCXR/2d
+P6xr+WTXtp3guVZVKEBeq3DDpj9/BHxWplDoavdBW5AlKXE0cIJf1d+3tbzT0bG5NQTQ/+emf3X+
+81+dLZ9FJQd6JVnX8oc/QF+WoFMrBcin1u/WtXSQJrAP/dHW/w9yDWvds85sbW938CCkIvFtaUPr
+dy1o6kBvrsf0ql6qoP9Ukv/rno9bsbHfplGC/7aAxyEhf1gHUngPP+/j5wP8fIifj/Dz580d8s8m
+/GzGzxb8oN1mtNuMdpvRbjPabUa7LWi3Be22oN0WtNuCdlvQbgvabWF/+GwTvtuENpvQtgPPdODZ
+DnzXgTYdaNuBZ9ABFgM/aId/gdDw8xF+PsTPB/h5Hz/v4WcLfjbjZxN+0O4jtPsI7T5Cu4/Q7iO0
++wjtPkK7j9DuI7T7CO0+RLsP0e5DtPsQ7T5Euw/R7kO0+xDtPkS7D9HuA7T7AO0+QLsP0O4DtPsA
+7T5Auw/Q7gO0+wDt3ke799HufbR7H+3eR7v30e59tHsf7d5Hu/cx4w7O+r1NW/4MtYTJ43/ArFHl
+1XVYE4km6KoCxySRF5WBy10ILPVqNir4ENCvppQyr6YGfA96wxBxRZBLES0S/K5c7K3n90Ww3SLg
+1BC6mBYFpCk4NihF36av7hPVhugTOgJQAJwDHVRQfXwfyTlSpVRVU0DMMAJLAntDhgWpT1UHI6cW
+wZ5QEI5x1cf7C3hnwAZB5PkR+CLmuPzXz18/fP0SP0uvX/w69Prhr0O/Hvr1oHz29PUyPl3CX3No
+9RK/P5G/ll4/w3dL+GTu1+HXl9H2Kf6Z/fUoejj6+sdfj/56AN8+wu+P8N/L7OP17Our+PciesJT
+r6+9voXPn76+hLYH0e6KfPoC/T98fR4/Z/Bz+ddD+HwW73v6+gLe//T1/OuX+OwF/rmB5w69fvb2
+wNvxNzffjrwdfnMXP/NvD709jL/H3sy+mcE3d/H9kbejb8feHn47jJ9D+IS/j7659+Yxfj/85jae
+HZU+xt8exDOj+OwX9HSPv0nbcfx+GG3530Nvnkivw2g//Hbkzc/4bBxtRt5MyRPD+OYJ3v4E2N0k
+2HgdBbCmE5jlsIOxO0B1tPoJOQrEhzG/NdT+tOwBf0BDsC18t+jDciaojKs0c6GigfJgbQGRwUyH
+SIg94D+jAWrTKj0Ghu4CA3KkEQdzfxC/0GSLrQAGMKTlDju7CokO7IP36jiGYRxC96pClCb0CwBY
+JgGtwUDM/gQ2CaQZjFCgw7gk7gUjtjmph7DZhVnqpsaF0RPT6AsKNGMI0WCDCJUpeiVgtyvaOxW8
+u+ZTs9JbYAC/Q9qh7B6vB9qAngEcAj6Akn8XgLET84CJHcWurlJB05CGRiv5oSriCehSPwGWTDW9
+GfgCuAaqU+9LIkBXImT0jE/xbJgAxBtTSP0gEdMXSkADfaVeDczo0bwOPUNTE//F0pVhCtPlYsCa
+UGtQx2gM6ENXgEc/RYhVG9BAuaZc9PfBvMaAwgjoL6bnBdgUOAs6UgUxDOyYFq35NoV+xpJUdTmt
+qBBamq4LX/wL0M7Q9l4BHOnCtHEpWrDiwOjlAHZRKMbPIESNpkPH0JtiPHYNERHrAqYPaz5NyjCT
+8CxeZ7DYSXmQ7hlO2gPpucRiCZczrSSwJyhU4oTOlNoOSIZ+WnAxfUvYUFiqgappIo+4xnfUUjHT
+uUwuFjbB5sUOQC3pqppWaR4bVa0GtU+5vwXgqlJahXCkn4TUhF6wpobmuMEGxRBQ2FoKwn66Wmim
+EXADGpgUj1WVPIvuSVshvS4hiYa+I+3tJbFy4OZjcgBEWwh8QG9OhIUtKtAv/Uo1lYIIuVsG0w80
+fRXtnKBHb5bxAHdrsMoCGksq6UthviZl+ihgV5qQgy7QTeBG9CfQGccJanpEjFtz+W+AYzpYDCVr
+/yC3GXhOh1Vfu4T1flDERDXNSnIe9LxfgcmqSB0+jEDCHjQHKdNlp0k9YL/ULRe4znFUAyoy/Rqb
+BxSD+ZEswGAOVwJgPSnLjhJd1aJiESThRlXNxYSJBRYHeuBOW68cxEJMfwj0R5zERFMKtjimGoXY
+UBEyMDEId3spMj4j1YFWq7XIdWFq6mKyFbjdlEHFCdcAJiUZh343k8aAO0W+kgSMjeZapUnUid1J
+dJ72V4VMAmNPBf9DyjF4GaYKdIslHsSneC/N3hBS0Kdzwi1/xf7oioSEjN0ywY+mp8/QzWi8KC3A
+kqqFbgJuohUeDWIvdbFGNoD1ArOYdikoYQAbCt6lt9LsIF/2UnIReNf6xNMIOyoAznN1By3wXorN
+KvgzoN8FIJcSQSQ1DHgKc+sENfT0GLrkjKJzAptnNJEr5Xgog8YGVLGE+DOhi9XQx8Zva2Qw2OTY
+fENfLwV3TO4OSzCgt/Rwy0oE3e2VFPKXm+ztIXOiXeglEaQPYITvbaADtECPCD1+7KUII80neQeB
+eHxrxTX/GowmtHXa22GCKY8GWqlWTXZRytNa7dxFYqUvOOfSuoN0DBNx5cK8yPWQiYH41QCdGLCE
+/f3alAA8Egz3vZ44omMY39bYwUbRHYQ0nhtTd/glGNGDkNCeAgFjQYzuoUdGh9983fvuH7f8uZNY
+d983JGooAgwt7APl96l9eUWrwYBlynS9OfQdE7xDnobQpqEayPXQCRCK14veRJAFVgPyPop7qXuI
+MrGDEAr0E5s+VRXxEMDs9kFz4JRYUeVgHpt7FGw4YC8wI7goVrm29va/0wVq6IcydBybPWRizDIp
+U+eFdE78xWAACZiXJAjwRQmX0P1axM5oqLLBXA/WH4wdp5SxUPR/oz7fTcmQD3XCLWmB+Vxzvmuh
+R5Iec0O3FkkFq+2BBr7lYiex+AwLUUzHP2QHhgthS79IQqrUfryZJpX4s+nTMzvI2OBuSDhKdJ4T
+GDqU6AjFWtPnXSG7UKtRnvpG3NteStGs9ylTjMHtdI9s/P57MVSqOYwVm0e3RC6ie6GrELf3FDGv
+2NAn5+lqUnbsCQDY1KWU52mDOC87IWNKId1UZpCj4mg7IVy6c3RfQ2VhRp9j5k4+n+9qB3QNSwVO
+mocgDj2HnTxWAHnHcQ3SAsSui9QVJf0Nz0HyFdIGYIjCt9iuKk9Q5KCgqz2Je+j+MRy8o0AhFEA0
+dQOIsH3gEjoNyjylMVS4Ln3NjoEQN4BqobiGjbMBlEonuvcNMQRdn0awO0moX05ihBnJ5x6dAJ2D
+VCeYm9EbWjtzznbCIzya7uujO74KmRjQydPT2ukXN7RRyHoKwBHwyujdhFaYPc9d9mmzl6oSSoKT
+0ZWegopzPXkTu90JJQMEWHfOJTosg9Zqm98T0ZekVR90WSMOq4LpMQmQhviqDWVHHgJd8/hAB/QP
+mnbXmHaeQHTyiAICJS0WCz46pWff0P3X0Q9OU7Bj17XQqw9Mo1Pd7Xz3Q+dH4gXkkcp3tH8VD5tc
+ztcHWZV5vOXE5GeCVEPPcq6mylHUSmf5NiJQnlYZMOKmHthAaYkOZrHSu2mzmB9asYJ0dHZWKVXo
+UjQ8XzAd+z7o6OCpQU78uAWqjkRhwSo+RBnRWwAG62qncxkzgnBq4+aRpPKYR8sfKmQSB/b+n3dv
++++u9jgq6bhIt/4O8sxmOkx5yGPoSeoCYyYJffFmM8cCCBb2tzrff+8E9AzyNCf4YwdEmgNycnhu
+5w1yxSngOvvU1v/3oPFB1V303MeADjUee8B+I/zFglUT6pntlJ2tzjvdjkfMSq8HXS0VqKN+XavA
+vAx4opanG9upEjuDp8MaPYmGCCe3icsxSEBOD1ald++2r/aSthwfODbc6MCWpzuhhQA3P+ADDIgr
+FfIlBF9Cy/AAjNA6CqvkX/JMS9QG3MJTCTlqMdSUDp1vIb0u3ykvKuj13Ezf697UQYXQyeM6J785
+X/F5iJaj2xtQA9uzg5irAHGoN3Ok2OTWP/GX7+nEBv9BE5fJ4ps2d+QcqliIvp51LVQQ3Z4PEECO
+xxdYcMrOlj8Wi0UwESALEV6O8AHKGo+10vu8SXgBOKsaWocz7CFuxSEeVbhcxBwnLadDpg281ubR
+0Gp5N0g66Xk1W9/76B9dPLtti1MwGk+eHCpIoPV+MRWqptvJ5TqhDKCPuGqbeCIB8a9CutEjSsPu
+qPs/Nu92KI1gmhd0l18pOfjJ9Wx0in2Gvzk5rt/ne6Pqdp7Y5UI9GNR2Yk/63f1asLIfECuTSd7v
+Kapvcz37y21uuKkDu9m2KdfaSRTezdNC6Lc0qFGWGDqTOrF6Xe/QYxE4PPSAslsnu+/00ZYgxzvc
+rTw4d13LIHEJzz5MSnHj8aijAKbpT8hb4KOcwzNa4GcMjU4Q8Pe3oNE0qvgmgi0CGeppOduhZ8RU
+Uxqgr2YjOk1eXU98SFyggETRJyIwnqeToMwUcoZ+lJhDU+isApEKuFqByorUt+mrKXpVjHn1OIhU
+LfVUUfP4gd4QAKOEdmaEUSWRoYfFCAXzjDZS9HYEaYlHZLWIR5PWAYIVIQIAXRj6VDk+hQG9mjIc
+iwJASz1x2tB6j4B8dUi8CLvJI8MS7On41XXAIs7Y9eO+yIjbRsVEVoAWaTUq040qZIC3QWcAmkdQ
+78qEPMYnNNOcqgIHah6t+eIHgihxfVUEtOTxkQ+4h4WtQlJEhRTTJLqOFUgs4J+KKBIoJ96Pfgs0
+4jAEkAFe9OpxJeIkoz7+CVzzaqHMJlyDGuC/qyppHzAy0FBcTKlI8KKoBPtA+SXg4gGuCx1YAHxY
+fO4llreC94C2oK+xd3RZhWEEAQYA5WLM4B66YA0Wx6dTqgxNFpsIoBcrhVUFM756HEJy7Y/oWBDf
+lBngmFU1qmGkIUEWGI6fRS7YOZbjI9hIGAuFiMvH6NYy9JKEJJrw1XXafL7YQLCdQEauDFfRlgxI
+G5g77AAMA6sBdK0Jk3UVmhhqkd3gacZC0C0GdEsvGmioFNDzxldjISoKIpnWsV3A0AdVf2vfYRSN
+IDcq06k34Ks+CF8g6Ff3qXNI8jFsYtBNnwJ5g55hfqmAGlkHmmYw0JySPQRYjUyQ8rgem4yuAq4b
+nqCFHdNPKITEMAaXB50DeDlGBSLxogFgJkO3n1GVQsRDW4/tfEVsK7ygTAqrHQC5D6sL2U8jDtPz
+XYXFeDXVF/FV2DKlhacoCsAGfmxSHXAEkSmKx4YeRm63TJDrCMud/ijuqoJFFhGWKdJuQt0QaJ/h
+F4UooAsTqwYiBPjigF4dkH1zGQeDJ2BrgBjYIwd0nTuNDnguRdcnJch9UieZnXyCmVApKQJm/OaK
+vZWA4dEVJQtorYZ1wyYFFaDLiABSnKk0K4BQfEyL04fsAmVjEhD4lCXA81VxxorBpkSoGZ/HuNwy
+2hsA5hA8+FjTfdNH5KgVXbLmXdqqnV5ELY2OofAJelLrVAMNGTrnaobDF3VR4KFMHGuB4S5Pr9Mq
+DV+QHgxbyp60ArWFp0KYupQ8hDWgQFgG1bQAfYmnGC8DPGVI5xWwYKwYJETnmAGfxi4mFMshaywe
+J1gVWqCEZiiOWxb3BPaHTsdYlzAqP2GcC9gWlhLHCd6NgrQS0tKKIRZpcYvDsEf79FwASJeSchHy
+DWZnTBc4Fg64DlQMWUsuxgS0eMfSSgUrQMs85upqr2qdTftgnnlVyDq3JiogoZVPFgWEt9IEeheS
+BNYcsETJE+NSHF1GnIRAUj52elDM9aoGMWoyXqkGCZkS69KRUARzx4wzQg+uUFJULAIGgw0xQDoQ
+oWLTQoVuCPoCxclq0iqjf4DRAphLdBN54uAFPCvzpDqV/sug1EEQDWYqPlvzZaFPu4m4BY319oKs
+IVvccixxWxWMEARPn11ZaCykssU4MUeIe5Aa5wp++zZVdOQllDl0sBk6ILmDnIXEtPCgCuOHWUkr
+G2sV8XAZFhT6wdJ/RpmD6SR0SmFtKbYrRPAaslkzakh7SVQqBVrc0KBrnh6Tbn3XhSIFFRFXVWrU
+ZIMqSWCNyOF1UhQnd1mwJaObcj2MadMe6L2YBsLJNbFVxWPCfQctgLZcDIfSqWT9kEbvcxmGQg9A
+ifuK8ZRlMYpQ84m4xK2H22C/MDYoB+gnxnlE8QApU38lK0Y2B4GRKQkuqIddzMQwegCAWzYj5xD8
+0Smjk5J4eMhTJCDQJNBZkSChBhEaVETQ0Fvswkwmt/qhxNspUssOcd5Fssviz4VU8bxA7+IJJMXh
+IB2YJeXWKsLj2Oy0VGZslxawremDL/khx62gmXy+CwrBJRaHAJGzAk/8ZIT2jH1RHH8k56lCPzUx
+ERkOATXn0t6EHAC/lz1NSR0LLYFjiQFSiIukwljBmi+eSeE1La5784XIFi+lfLDeRDk7MIz60h4h
+hXUswRTyA3AuVq9EbzvPdwvCO3TlMRoLrGM+FtlFnywtI3pWXVmrgnhZgWJjVQUdYPesLwzCg3QO
+SJfUMAvSXhWWY9JmB1GlvK0w5KdEjyMUfApxDFnP+BaAH/Td68Z+NcEY6JwnkdKqgcjzfHIqHWEk
+e86xTbwIWEesA6gQAkEJDfAkRXt0rmhvh6whFpgOtDKdFSpgfCHjCXMO7BvIDa4JbPfv6LXBAIv+
+vkiOCz4ROs8zPGaDC+FBCiC+AFzD5AMF1FX+SiRhIjPiOQb5PIEM8oT+twunw/pIk5q4hinfSlHS
+KxJMUdIDqQlFQypxtFYjiEWccAdjzW8+FwkvWgi0Tfd8r+gCEfOGI9Deu2HBVDvp1DdaDHGHqjr0
+uui2dXg+7st5TXcOzApsIdF9wEoDEj1Ba2irs7esHY8etZKcS5ntuz7+9Avnc7AE9TId9a7YA2WR
+zEpMik/lLRQ8idlVwWbrXpGl4Hdwg4e/3cQeC4B3wOm9whf5lpYvQ3HqaI8mS65nD3C4XxVnlilL
+ZAJ2DT18KVIilrUXIjah+BN7Rdp4mk4bum+DmrNB50v5gi4z7iIinWAiIByeA2iJAsQPT53EtZsY
+wkKZlW/s8ZO3hQb+94R0nqK31a3xBAhKTmJfYdDvZ5Qepb3QT09B1yD92sT/T+lUTUQbesQzod4d
+RXQIKEZ/ikOk5RuK7Jx4YEUOG5Nz2mHD5cUaiyQyVSgh/nIfo9E4PmwwpJk/IJwbFiXKaqd1x9Ie
+zalqldoMzJPrkcNFwxjXfsMIYDHBXHpsoW0wZ4xWb4NSB0rkshEEYz/3iIbtKsQYyyCVSyyndOKX
+0d7339MtxPnnenyrxcBjqkbOz/Uw1gjyRIewPyHXUsoQgLhQ3PxmEz2D34v64RriIzlNydFzg7eT
+iDo5EyW6TAWb5IyBn4DIYx6UFLEcyVZxPDhy4pXfJlpADpaMscd9lBveJ9a5T39g/PXe3W0f5eTo
+0dkuWiDv/B3ohgeFfiT+YuC3kLFBMdBm0vffqUSqkD7F1wIEBQGfAAWBD2DZlLjjZE16SZzvfBMr
+LLSqCXpx8Up6DXM9ZZEYXAwe5pG/5QjJyHlqpyyDJ87XLlgKwt3cw7Y2RrZCQvlJDZNhtAieV6l4
+w5xNcpoRFRL6p4nTjBJX10bn09Clo0R7NeEgBzoE+kUOjvOU6NqTE6HaNyTW/G5BdHRca+9z0ZiB
+0Y5f3COYDXvdr2utnRJLK6Z1SbCZHC+bnYKBf2CQ7ndgHzTfjj672otC4XKymS8KHpYzCtMlnl4e
+3DgdVXxWUSA6DEtM4BhmAGw3wSeenMcZRs344fvijKJTCJxFOyDMExBtUAMqERsAq/eNTEkowQhv
+Kjkp3lgU7SboS2LRaQFjmBuEO+JewYGkXfbs1XI9Lf9JYbe3zG2G5sFM+whpaoxl72rnUW+xBhyL
+LnzYM3FNjnC7d4gUFUeco+SE5RuBcTyGVR7l0gB0sYu1lfOWll6xDpyddAa7BCaxnPwb8Wh72+i+
+dhjBG9S8f58v53qYA8BxUuDJabqh2ZwaiUegk/Kf/+qUOIR8KYXxEwMfMNKVI9zK01ntfUlw4fBY
+I+dAyqU8kcEmw0TgYZicNWOVAFOKwC7QaDusJQaDGVJUjoR7Rfu0tPwdUjlRIJtYogO24kFGV8dE
+J4msXq7V+ZOTkx3vKaTkCDnHNZ+LjqbGIZKsCgSkTOYxVa5nQMIOxAaO5SwTGgpghZZgQtdaItge
+mwfNFKqBnn6GPxvKjVyPHE2bbjkflgNhpyy9dViGb2n5NHQcCZPYAzu132+TRcQ7IAe3i8aXs0Gn
+TWhps8gc5kjkejrE5fqlSLk2EU8V0hUIg3TLeEanQ9jRkeAII9ZBqxbrj+jbjxkbl3McayRIz23C
+/NBfu/ZVt8qRKnMm/JBGOxAjAzmMnGusk6Nvp+UP5MEeBof9AZ97PS3ybUtBlRk3FHOTSoy0BU+W
+YNFWZY4mruLfEjEztjI3VT832pgeWnl5ujF/YPWXMyvL1+sHZvB5NvmyPnZqZfHBytzQytzP2fCD
+bGKuMX29cXKkOb2QXZ7MJmZWFm/XLx3Pxq/Xzz9bPf8EzVYWFlYW7manDzRHf86ez668OLAy91P9
+6u3GpaPZ89srLy81D5xpPF6sP7pev3Sk8fJU45eL9SND+L05cxjd8r3LhzikX641ztyrjz1fvXNu
+9fpTPjg0XB9Hy5nV89OrNy40Juey4ccrc0ebL1/WT15qPLmx8nIZjzRfYlTPskv3GgvLK3OLaNl8
+erh+7mLz7sjqjVPZ5JXs1vH643vZyDG+ffFS/cyz5vmJbGQ4m56vn7jXPH4ymzuYXVqoPxvDOjTu
+L2Be2cSpbO7QyuLQyvxYdvtlNnG0ceZq/cliNrnUODLKb2fPZncO1q9cqh85Wsez5x6unl+sXxrC
+L/Vz89mLiezY+ZWFB/WJkytLkxz2won65JPs1k/N5YtYNCxIY/Fq4+rt1QOn63Nz9bGJbH45OzWe
+DT9bWTyH/pvX72XTR7Lhe40Hsh0vfspOXWguTzavH2scnM9GFxtHxuqXDzXOPM2mTq7MnWucPdac
+XmpOX8+GjzefzNfPXmwefJSNX8uGb3PY4/fQLXY2OzuCncqO/5RN32iceIhFW5kbrz99jrmsvDyb
+PX/UWJxo4Nl7Q82ZO43FkcatpezYQuPiYvbybP3SA+zd6qWh5p0DK4vP69de1M/M1I8dANmsXhxe
+Pb1UP3Ebv2fTz7LFBQymDgKYOLp6Ybg5s1h/fDZbOrry8njj5TReUX96YnXoSP3ofaxG/drz7OXp
+7MjxbGykMbtYP/Ej5phNXluZA13dql84jVXNTp5Yvfp4ZR4zPd489HJ1CMs4imagtMbdUyASUCY+
+x0uzW6PZyTEQT3brLkaC8WPpGtfONO4/W5k7jfZY0tVD91avzzcmp/H21dFjzeUL9Qsz2Yuh7O7R
++qHhbOQpVrV5+BRoknR1+kDjyLFsbjobv49PsmPnSF0LJ9n/1B38f2X+WnbpYXZ5qP50onl3rD5+
+Fg1A+Y17R7FQ9dmD9aEToCLwSzZ0MRu/inGCSvEVBoBZo3Fzeia7egJECMrBQnFPX87Wj042D1zI
+bj6sXzixsrjI3TlwO1t4Xj/7sH58urF0gtw6+7L58s7K4tHG4vGVlyOYBVfs6QHQKlgSXAZu5Vxm
+LtcvLDVuLZCQFiaz42exEWBbUBTWvH7lJMc/uVQ/PwxSxMiz4eeYFzoBlWZj58E12Mds7hwoLZsZ
+aVw7ALYi3Z64m40947PHFrKrCyAPrC1GhfYgqtXR45wjqHfhWHbuUv3BDVAvqBFdYZHJBQuTzaFD
+zZmzoHaS4tX55vQUBkyCPLOcLVyqj2G7FxonZrIbh1bvXKzPzWQnj3EZ782CEvDU6hBEzVA2/RP3
+buIUN/30gfq10Wx0RF53onn3ZjbyGCPEwopMO4r+s4nx5pObWNL62DlIGDACZM7K4k1wXOPuDBYk
+uw1uXcA6c6ZnhrIz09kohvGwcWcREiZbOAOZg2VBe1AjhtR4ca65BMFyFdwHudecuUEqxdZfAv8+
+pnC4cjhbGgPv13+cqp9ZaiwebiyOYo6NqXONySfgGpBENj5Zv3wbdFW/eHD13GkKz7GHjUNTq+fv
+oZPVM9OgXqzz6uUr2dxc8+hsc2aqcXEpW7iTzR2rX5okPdx+XJ8501w61OAYRpp3D3NlyInTJPjz
+9+qHQZ8HGo+Wspf36+chximdVn+5QGlzD4JrfvXyDezj6uip7NZhyHyIl9WLJ0GKkHirp5+B3cgp
+mNTYyMrCL40j98kaixPNk7frzyFhrnGE08+ws427x0FyIoefY1JkuvHr1CanxpvTDyFJqH0Wjzaf
+3FsdnWiceU5SfDmbnT6evThLFTB+Gy0xZu7F8i+rQ1ezH+9hVbn1D3+CJMdEGmceN2dIpfVr17Ej
+zZnb2YmRbOJRNvEzuKC5fAZiv/lkamX+YXbyeOPuQxEmI6AoMuDME/IUxPLCmWzqvsjP09Qv945m
+CxOgk+bYg/qlQ9np6+yNm/gsmz60sny5Pn6rOQSZc2ZlcTy7db/x8/ls4iZEa33oQGP8Gf99ZD4b
++6U5fQuvy5aHV68vQuZDI2QPJ/jSsVPZEKmU3/78I/Rvdmy4fvTB6sGbkA94LyUh5OfIMMXRSUxt
+npwO/r07gpmuXrwBxqTeXB7FUBtnZqFTSKiQnKMLss7H8VV96iakOiZYv3C1fnZ4ZeEo6If699oo
+5kj5P369uXQanIg3gvyw443rQ9AXFGULI2SZxYXGFOj5NLQbFdDJQ5C3JCool7Efs5l5vLd5BPw+
+Q408coz8C1lx6Wrjp8N89pejjakjjYW7EObZFYimidWfj2XTV8jjY88wfQwVUAHjaUwtCb8fzU5c
+zcYm6+evUU1AAwISDB0lrgB3j43Wj41mx8+TC85PrU6OZJM3RCcKc0GtT16rT91qDN8Fldafz2aX
+HmOOJDno0+dXhM6vgsgxHuiR5jLm9QIKgsxOeXganCt6hLqGamXmMFRb8+6RbOk8OeX4Caqzhals
++hiIp374Kr+aPtK8OYwG4NDVg9PUERCDC3dWb14lCrr4snH4WfPlA6KU8dvsbfohkQxk+3W89xgY
+n7v28BTFyGlIsEvN28vgRGzi6vCP2cJ5PA59t7J4of5gCRIA6p5SC7s8fYwClsjkHDQdNOnqjZFs
+5gVRFuZ7bBEUArnB/y9OZMNzXNXJa9n842wCXHAYXJnduAI+rV8+CXREsrxxZWX+KN7VPEBNWj87
+hh0nbc8/ATCDsiPFgiDBtjNHgDegtlaWp+tn5rOJgytzJ+pHTmfHH4JDIY0p5W48bN49SHAydYFg
+7NFS4/aV5onnslD3MdnG4t3G4tTKy2vAIZT/0In3rgMpQZQRb0AG3rq6OjncvHiifhrq7NLqzRPA
+GOTHp8+h5UmfL08D1TSuThGyXr6Ola8/WmycO99cPkmEs3AXs4C6h8TDsjTHgGDHQJnEn5NXgRDI
+VmcnVoGgSNuH8Qqix4eHKLQfjxIxAsGO32s+uQoFkY2AT9HVMsAnqLd+7iXhzcT55vQ9LA55Fgh5
+4vjqnTGirBcLFL9Dd5vHDmVgeSDkoxdXXpxaPf8I6wkipJa5dLx5d4iCfeIgHgGzQEiSB7G/y5eb
+M8BRSyvzd8Dg3IinR6EZG3eJ3MDgXBwosumfmgevN25T79TPTQGJAV1QB2GFx6+Ad7CbwK6ro6Oc
+18nbUF5EI9i7yWmy29gsNG/91GGii0tHqH9vjTZvviSqgSC9tIClg9bLps6DvLHRkJ8U8iNg2FNE
+TZdvkCOAHikPr0CwsLeHT0BgoF7hpnFgmObDG/h//eI0uIlSZQ5kM77y4hqlwdgv9alj9clH9aO3
+RY9MEjYPHycHTfxMjXn+GRXx49HmvfHm0hL4C5zCHZy+QRg5dAC9AXjjXTAZaJU8eQLp3Xj2CLiU
+yB8aavRnsA+YHe+ClgHUAbmuXr7P/VqghqUcBt2+gAK6Cf2ejV1bvXiLiz821xw/iIUCbsGO1B9c
+r5+dg8rOHp6iJh37ETgNmrF+REYFfjx5gghw+J689ylky8rLx1A6jakzUA1EGsuXV3+6BKGHF9Vv
+DoHGsBfYO+gCTLP+6EduNGT13FzjzE/N0cccz8kRYkIgPehKQJpbgMTP8NLmlePZ/FzjzgQxP1DW
+yxtUZKMjwLqUJ4CssHHGHtaHLtcPgQhPUI8cnwAXQBNhasTPt1+CO2jCHDsCUUlShASbh7l0iQT2
+4hfQDLabxssRSI/DIEVsd/3yMmgVIA0iC4xGc+nCacyOkG8cnDJJEQ2b4vgoFpxqeuEO9+vIffLU
+2ScAohje6tA1Sk7S2PnVq/chT5pP5tgPJoitnJxbPX+J0hja4eUsNR2sKshz4Jnpea4wlmtcrKTL
+1yEPaSoCPU5za0DwAnKeZbPDYBxIzubyFTApFFB2HHbiEh6kafD8bnb7NkQErRhoPRiwl29bkxY0
+DNojzUCZQulA2o9f4eKDqe+MQaNhg8Bfq2cuYqM5hZNjwHsQ6dnQCxAYJzt5qnH5JvQyRBOtsIWf
+oZ5WL1CdUaIeWa4fHYaYXT27LBy3QBQBiwlaGLbJ4hNwGYRzfX4pe34nm3gM7l5ZfAkSgqYAi8Gq
+gvaHrKNRBto4czU7BfPqJrgJ8JU2OOjnBahivnnkEUgO88K+01QEN0FiwMA/DPo5TlE/fJfbB00K
+wbh8kmQDobR8vvn4JjHbMwDy0ezSVULlpxfrs1dh2VEBnbhH6AujD+O/PEV9euQurTOQ5dA1SBuC
+FrSZXxZbcqE+MdFcfphNXICVRJZfvEp+PyK8cPF+c2aJEOsYJTZxIyw4vPTEInXQkeOwymkdzD8B
+FdUvH8TYQAMrcy9BvVTfF6HdRuqXfuYcIW9ppMDOvQkJQyx0ZCy7fL658At2AfPNFkYwbOB2Ep7w
+C+3xS1ebs/L5IdgClwh3D043pmcBLWinzx6EEswmzkGWUhvCUJ2cw8qDtYmKx0ZWf7wKuwZ/Nq5P
+E1dDVi8dpWV0eQhWQ/0KLIWLzRkgommioOnLzSeXIVEJWm6ewNzrJ8Rah4U182M2fQ08ArFDuHX/
+bPOXc41zS/gKhgklA0YCwfvLRWrSw8uNX27XZyZEpExltyxHj+ATap9b9+tPboOtiCQnHjYO3ARF
+AaNiyvSfzP0MfZRNX6A7AiYDkP/kHNX02IXG2duEvnhqCWbdGI2jpWUC6ckpUCDt0LHlbPZo/doE
+xRGMRKw2yGb0SePnA6SoA6cFiD4Qd8ppMEX9+k1S7PhjCJNs4k526xz5d/gQ8DxNAAKGC8Bs2eSk
+aOfrzZ+B0k+s/nSycfcA1orLdfcwVGf92VjzyXw2PAsuy5YvADjRSQUjdO4O9/3kcUy2cfEFfSMv
+hmBJUY1Ss58XTUFKg8SATUHLUSw4/I7eYK2IiXoVOpqAn2iTPiiiFCCBy1ehqrDX4ALKsWH6KLA4
+VEYvLjTuDmEZAfywJhChqwfG62OPSEUTN+nbWbiVPZwl6li+DPNcLAgw8kG8mrKFmIousmx2liQH
+dXn4Kri1Pj1Oirp+pX7yUvbwaDYLZXqCBtrY8+aTG7SVbj5cvQrYRncQPiGcu/wT5cb8I5hFmB1F
+HDDV5Dgt6Iun8Mkq+P3YMKS6+NCe0RJ8OkkIB0Pg6KQInGMYDGXahZnGpReNy9gLQJQn2T0oiEki
+SazP8V/oOnhxoX7oGsgyuzlKYTg82zwyQyfY8DBdNA/uYMdXLwInP8NciF6AQCZmYJPiddCS9DzA
+coF1SRtkiR6DGy/Qz8qL8wC99fMT9IPBWsSmYOOw0VPocAaUszp0kAL2xFXsNUAXxSA6OTcKWgK0
+biwuQ8o17hEFNRbHsGI0Oo7chx1HH8vJS42jAIGT2PGVudsUeg/nmsuXspGLnPKlI7BkG79gnY/A
+fmnOXgeZYaE48tsvG4uX8WF2/AAN2+VDzeVJTAqGAPQRnU4vLmTjy2yJwT+42Xw6kc3DQjlDZyZw
+Apjr5CnRDg8pqIG7ppeAFSFz+Janh4FgyTXXnqz+NNG4PETpdOsunYEXZuozZ0khFxcoq4+dI5dN
+HyPjYLOunoDwpPkD8Qulf+Q05G3jzA36N+4cFA/VPVp/y5cbgFiT1xpPrlE+AFsCex+8R1w0uURv
+5OFJUT3gkZtQWwKZaONTg08fqZ/4sQ7gN3tkdfSYSLlJmG80bO8vrN45B/BMi+Pc4dX7s0TXc0fR
+huswAuue/AIzfGX+yOqFx9nwEew+/VEvDq4sTGcjAAZHGyfuU8ct3cK+E0M+PAWlDDqhPX5vllp7
++Dm6bZxZBGghenlMoxXqnlgUQPrQS9A2HXfXH1BHw7K4dLQ+N1y//VP95JXGg1P0El87sLpIVAbK
+IdnPXm0encB2E53OLTamr69eeFafftq8t5iNjDeWF5szpyCEwTjZgZ+IiF68WJk/QaNveoZb9vx2
+4+hQffjoyjwA51zjxAyZ+irE0SLXfPkp0cgVOnuB4riSsJSPLWM6bsowDh0O+Izr82LfkxDJ2A99
+148SX1eqUTWKv021mzJQhSeeXiQBs6EEbBrWO4iVBM6GYVQpxFriZ8OqjpmEpRi/KiGlxvVTT3ly
+zh6pNESf0o/x5BOJmo+rscZ7jS69WggljtQMRIzlkMBSw3SSUJVVgbnLJTlIY0iukdBCDpvRKN+m
+ftUGPhqJrTUqKKWhcqM41pFN7mRIrx+rOPYLjD5Eb2UZueYBOA8yOE5VKfhrEYmMO2WouWGg36up
+UCvG7bjaBuFK7KiE5hYUQ3BjiYXFeFLG6UlsT1RhwGYwwGhAfo75yioZCcA1EqQbSlUAJZG8hudQ
+jGbmKbunmc8dFXnGJpGeRuJhImZO+EqCMzlHrJgbVao2c1sbifbhrsV4gS/hmTy/tZGQscR/Go/x
+N5hj7PqqGnkYBLO1k8jzOVD8XggkqJhRAphrwPodfuTGvvGxm8xDwk6l7AG/6yIpBKsSY2UlvUpr
+BkZFEkAtEcqekh5UVOTqYQXQVLfx4Jwro4yclRuJVYsYs2T7ZzQoViqSuEEjUdcGk/UZZcucEQmj
+NrHa/+ox3lnwOdOUMW5RoL0o0SH2F5N9tWAkOo/Rsn6oGNeE8XJllMuwpsh9dd3z90vcJ2P/8JRk
+eynu/n5SuB9E2EWOmSQfv7q/z8c7Xj2u6v2kKk8NvJrydKQHeHZZVcwCrmiGkDOUlWlATJcovrru
++oGrCnhIIoIlfNVDn3g66lNVzEKCYU1BKEQip42E0cYSLRwWZbWx19xxhvoaSdflTDH3gh8UVESO
+AOGQKw1jmJTEN3rR6yevF3+d+HVUsuQXXj/Ezxyz3n89Lp88+vWA5Mo/xu/Lvw7h84PS5umvR/Bz
+SPLqf5JPZvHbc8mpX7SZ9q9/lGeXJQt/ir1Jjy9+HcWnL5itj78X+W9m8ePZJcnqf4zWa9+/PsOx
+vb7K9/I79CcZ/JJh/+L1gvT3WPp/xN5lbC9eX3997/VJ/Pchnr1rZyHPPpJv8STGfUPG/EQ+41tf
+yHxl1vj2R/kEb/l1WGbNvlljgC2f4J+X6PlH+8a1sTHX/8ffR4hnD6z1wDZD6HH23xUD5vDES1nT
+K/IP13pE3v5Q2tg6BqwssCSVA5bQM8dp6xcs/XoAvT357dbZ326P/nZr/rfbY/L70G+35uSTs7/d
+WpJPjv5268xvtx7+dutn+fc9+XZCGuD/y7/dmpKnRvkgP0GbW/LUvPx7RB6clzYz/IX/XmIbNr6N
+3/sUeVpaXuL/+dTwb7ce/Hbrjvx+/rdbY/LVkvw+IZ1Myxvv/Xbr6VqfHNXt3/+Nd12QkVz6vZ8h
+eZ30w9+fSst78sm93/tE+yMyi+nfV2BaWmIMj+STOeltWp66I5+clqdG5MMH0mBKPnkg3f7EB/nV
+PWlzXtZ2SHobejP/9vDbI29u899vR988fHP3zY03029m38y/mcE/d3//HD9Ppa7DwtvxN7NSyWGE
+9RnQnjUYRtB+9s0ztDv89tDbUfx9F5+OvR1+e1DqPMxLJYe78jlbsGLDz+j5IKs7oN+pN/fRE977
+9gA+e47e7uHts9LyNkeF34bR4i5aznC0UltiXCpJzL95hm/H3x7A71NvfpG3cmyj6Pcm3/jmwdpM
+RvH7Y9aiwHhG3szImB7Lu2YxO86UVSUespYEWt6U2hTD6NGO+GeZCUaAp1ij4imeeoKfKXwyJtUq
+WA3jroyWT/A9s5zX789ihDfR9xjmeRdP31z7fIRvk7FwJmP45yDHi5Yjb6a52vLbz9LnMN549811
+md24tGHrp9wbWZ/ZtwdZHYOrxDWWfZmRcdyU9lhn7N3PXF08MYLPHqCPYRnDz9yTNw/w232s0gha
+juOtw2jPWSy8eYRPRjCHx9xxGc9hqdLBFbajO4j1uSfzvssVRk83hUZYteM690TGMby2d4dY0QPf
+kuZ+xjcc1b1/r/pjWZlD8q55oYoZjA/jkrdyHealt1Hpf5QzB93MvnnOMUhsmOQGMUaSsadpkJQZ
+5BoUbQS2wDoGPKVViUaUMCWPlTWkuoNEqhWVKftR2GVj7CXkJK4B7khOr0TTM12futtmApg4DUPJ
+fo74OKsRMJJXYpOZXcM8zkEdSNFF4DM30GkopRdCzaz2fqIbFda8GtSqTcs3EtA3oNwa+mS0ea+d
+EcvRoFPPl9yIWEt2cizzxRsKLD9R9qVohM0DMGvhynwLw111zHhXRsiw+ECZkdGBjbvPK09yHqWy
+h/YGtUQ7C7Z0mVTtpS7L3PAvgBmGfDKdzVWhTZ7wTCrTFdDM3CK3zLSFNJY6FswyYcGRAsBFTaKU
+pXSnYr6nFG6LmZiA7yqKae7ahiUaWQICgpBYAYMoMc4PIEcnNZuPIWvNVDKJyfcSQJ8S0xQYaWsY
+lsl4uEBydwnWQkJ3hijXCOIxXKaMM8xJgr6YUleV1ISAsUw2l2RjHzrDI3i7bCfrrqFv+6Xex+BZ
+s5ZbUWC8pqt3caamvMPSWVKOGXK1R8KlHWBEW/aBofGJGpDoOxoImLukG5V0L0iBle6IZ+Oap11G
+NjFZlckjvuH+se4HlpjFE/yEdTYZvFiShLqA9MGUAS0tjQ10kvoYrFEh+a4s3gh4bbMcjE3KMDY+
+m0W7uGYkMOwAY4B9yQ4haXh+ici86rtcnm225kqFxgZXXjHoN7A7bdNkrEWkAhAuKdJmT7TbsHgj
+cZ0AjVrixhgpy/mlJnEK2mZ2mL9Zak2ZbBDUNJPOPOZIMGCNAf7CYwN+BEiJhcTnHKUkHQjNd7Wn
+Qc+6Fga9sqhAIHVJJJ7PDxnBx9BNpvKVtLJTYQE0gHqJwNdeVdIMwtS+f6edu6skrfvvltUYSZsy
+KQds5ILKwSPa28byIUzJoH0D0ihxqxwb52aDehnHR2rYljLrLCnpROYuqS2GQ8Ffe+wWu0r41ybI
+MM9Akl2I6l3N/AFusKy1IdXBMuneJG/6xAoBp9sJ9aCzw+6DTW3yekkhKvjCyh7KEF9KOJBJwNfk
+hx3MbitJPaICCzIyZNMUtOSSSCy49nbqNDFM6QlJ8xK/H9s6OywmkwzqteT+oGbs/EBSnHSvZV/I
+lyrJc1/ZL/gJE37faWvbYYWH1MBhQvUA9yFKJQp0Le9lLTlmgxVa1ZQ2oBY53Z2zIfcOA86lMIdQ
+yKdiS3JPQAXMIgKb1Wxii2nbxJqCLZKIEFd2WGHF4rShzQoigVWZWlNigRSGSVrBKTH82vuY1B9C
+CjMLyGNobVzp8WG5pJ4eLGtLUswE8XrtjrkqYaD+NqsDbE6RLfAEbgh9Pi/SRteY54KV38HswFJU
+ZskRVfvYcjgzhkC0G60IEpICKWrWpS6RGQdIu4aBiZJ7BcKnAApq+ZYWJgvgtQ6IzAPtgAO2QzJg
+c3dQ6kJJYokdlpv00Up/Gkst6pwjGdg2jcPQvvWlKDOzFT6zIr2cSsKjHzpsBUmg/NjswAoynZkZ
+hiooxDY825c8q0Bal7ayDm93zmZPOGtJSoz0x5qBmSt+yiyQfsx2W4VlSNUuT3Lj/2Xn/rnV2kxL
+cZJIIju7c1Smgd7HqGlSQSKZBwVGzg5oqfil4zabfmfT6QxrMuBxlvfF3HeDGYrRPio3BiZDV9Fo
+FmEFlbXPr6SVshVykCu+F9QgnytShRRUAgWPcbLst8R7G6lthalgBNrmVqes9hoqk9RAnnir02tx
+SMwMxVRTlkSV2lcWVmDNRDdSJlPAoWe8Twl55+0SbLOoZJdHjewYXSIXfxVBlCeOTecwe5TrW9HM
+3IC06gxK+SKGu24d1A7Lfm8TLbmWpWG+sdl6LLmESStXBAQrKGPfpeiG9rY6EsqbWDBUoNY1SQE7
+UfQTxkVjgL1WwLMyKdYMEhgdmkok8oV18vAf3zgsaM65x0aLpwRbwfrU3TlRdSBoVhYaYMw/UxG4
+D1K5tKK5ovvw/orNB461TQzaqsWt5mGRHNYFYni2Ag+LSh5UNS65sy1lHqvNMjM7LPiyWWVGisBB
+j7NompHUEAxCKmCsa6mypjU0eiypkS7rjVcTwW4MJI4lWc0Gbds0ThYCY4qcs349GQ8ChHOHMg0k
+hxXCHDLEqnkLsLQCWYIpmVYt4oLJaqY1L0k+knHsruUhQZOIa8f6wjwb1O/YHJxYiq4xTp+1ivQu
+CfKHMBZtv5ZkucNCP198ZkEIWU7okMo2fmNTIpjSQTpjQHSuh0I8ICyUzqgI8F0bIGf/WhqZ9v6a
+sjwy9VFVKsRBC1fM3jJUnHEq0X6iG26jL2VZmNaoLTNDwFPcbRWK2lhm+S3tfRnUKgAa31ghaRNZ
+jRSnwsQAqULfXcuitOmMjM8HUhQfbiLCQ3bE5sdKkbGgVrKJsFIiC/Rni9RQ8bEbqxQt5edZlaLs
+V1mwx2dqrYguyc4hTGFBIi9NZKuYHcpsRAsZE/m+JLwWMBmBOYTbLZ4H+uV7f3CkMM8eCyehIimt
+WLIeJLYbhAFBb9MTjWTSaW8XeYcap0htz1RcAB7eVwBp06tlLm12lXZbKM08sqjIIiYsHySli6iu
+Q+ZcslzWho7WdpaS7pPq2hQX1ozoTXS1rEMmEOjQiQpiVEh5pnUtn0vmmcMVw1BzjhS9sSnLBnpl
+N4i8peVzsJFjc7OMxPJjH6zUK/oCyVgkA0Ojw1TKGzLrAhaKrBILJrk+jBGAdGP6LC19rFkswNnO
+IjlpxZYm6kkGfUFmERMzaqzuzvJzCrg81o5No2ANGdpj5ZhORDIt2VFysgRcQpSyavG6FmeHNe5A
+6+BJlvQ3GCDf7igjb/fBPpJa2EW26Oli5XsACUEO61psvp6xY9F2QbYSzKIX1yLjTTb9QMqLtTjG
+7ljB1syyCafOoJa0vI4OW68N9A4iBAylBJPKHxCePlW4U/B5rUKN11s4LGrqcANZHB1/vRsrqIRO
+XtfAytusvBaWNlji3Yl/AVUxeY/06TM1hKWA+IZtVpT8DbAM9ucAiJFfphQyxmUJQhWIanW6/26F
+h1QJg4h1RJ3aZBWvS0liLxORsJjONsjf2BcdV9Y7fzdXEygSj+kuA5wR05Y7C4qCxbAwN0cGCUij
+GCIDa0od7ijalLQ+gUfZ2V+ZkgjIIZkmkvBGNWHTNGwaiLNbcl0cohmyb61KcpPShy5txYovdRFD
+xy9aoymxCWEeCQivbbdSoGCzbdrEbtnKipxi9zMtOy/5U7kejAxvTWCDBFqsPdK85PzgOyxy6ie7
+5bIBj3lP5AvqAWWkPl6uB7PkOts0WQBI3hjQw2sxnILcMoJNVY5IrQoN1LjWLplT+aKFAB72BOqM
+xWmg2VyL2aXkgdgytL78RLR9mIpI+qvUUHdEVJqE0A9/FqX4JGUBuJJlvVh70woUZ7Accbgk0pRF
+NKQwhQHIx3hl6VgyU/LdbUK36ZWaBQ7L+HM3owq3k0gJREX9hxfZpMWuQo2b6XxhIeoe6+ywKa8s
+p0n2YOISdHkv2AP9SU23oLaHmgkSMnJ4gkXLJqWVTKPU2CoJzBmmdjKSlWx4hQqWifWEaGUpyWui
+xQ6hY5MIN7TZRCwxI3I9tHoSv9prTWdb6cDsTeN+DEIKp+o4LyU0NwguaGkpWBqU0nW8hoN5mmuV
+H7q/Yl2M2JNs8qBW5T0ovpjF/u8pRC2S6rT1j2kgDCSHZkYuUhAJBlPAlfLwAh20m675Q4wUU8XE
+dlonl13Wlq2OVAKUum88xkohHCpiUGGr6J7C+PZJdTtJb13XYqsc5P+yrxI4A3KvjdhqrADHOgLc
+JN6pgDYa+Litrac1D46NN9iMPcPLVfAA60Dhf2JW79d/YxooCEXSREHjsduda99jfUges8vpSRLM
+l1gguEdKaTi8tcbxw7+ycltZ3kyAaW21LslG71GOwGDe2xCulytF4o3OLuvhKcK2Iyojw0TxJynN
+odo2P7Y1DmQqIBtaLp9LmZXA5vqZPWuOpY1AVZ7j2LzBNfcPS9RKJn0RLN1iS2CYj+UmHWebtXP4
+bEWJnrOVMQAQayxxJzpARDPbG0ovIF7fsHYcLHPWyY+YhAYcnMQ7bLWSz1MDZGL+bs0rGFQVwabi
+vaO2xnp+KnmiG6V2ZlBrp537jQLLyJ0CUotgw7ZUyiySRUFu1ru18dNQOA6QmDv2iXZ4SY9NBTfb
+5DC21vKHP7Di2E4YlRpCyNpqkgyX62FqN1hGcM9+vQ12X1lVNtjSEd9ZBqqm0nWrqLENNuuxZee2
+vdv+6fwncSJwhdhFNCoSjF4S1+mFY3XFmvON1PtbL2IGskxSOY1f3EDY8Gkiw32Xl9B0OrkeqUPL
+G25AL19aH6e2QJdX4ojsYe5mWBL040ShlPsVWFGsuZR85Crrl0qg8wdDWtWs+RkOkDyFUcMSNwzf
+8QoQkHDOgUXcnfOYsh1V7RsSzIEUYDNkDYx/joLVKcHNKnBpvLd2iqSwyYIKGIJyfkDUv/gOoV63
+We8ra/PqNFbWhyRH2mCVICAmGlybmKgZI86/Yk0EkVRfKfpkbQzaTXZarEiTFcvTInvq2IIrko+J
+Xj6TkgfOdmrYqEhtKO7FkBfHUA2CUvrppOxPJDl5vU2MzDm2lE33oJWm1PJf+aXOFpkfy2Fwhtuw
+URsdN06lfg3rGuR66MdEv1Jw07CYHY1sGgbp74amsVnBDtiQLgrBit1OyzopeplEUmTgK2ux77F+
+4d0Wa+yho4CleaR0xd/8mKS1VpBhh3Vr7bFo1ObCd1YJFVjrQtHl+ZlYq46tY2K2hQBMYc3qd+cr
+69rZZa1ya34Y11I5Nh2UYqEYHShi9bTZQjRfWDwouaAAmb9Xh1AsJ2RLchjeskRwIsWCjEi+Qo36
+Vup2x/3oE/QJgqwBfNIVyO/QubLyjBdGYeNaWnqhKJ31UgV5va26Yfp1jQXDRfcHAVPU0VIq1rZ2
+0jupBx1brceRWtkQprHDBGYppdC5NtpO8b1CH0met7EJsA69EToo9lqvym4tSdsDooS11EMyZXpg
+oZYS+peAZF2pmUB17rBKFLWn2Zn2UyraMkEbxSD2k0Er8ngLETZAOZJinNuaKydJlW8GJ9qqEy1S
+diio2Vo0sWsNBzI6exdcF/ba4wT6O/GigOWck1rR2hYU8gA12tpVJqWjSfeKFemULV1/JSVOQqkf
+YJJtAd0pSpSNeD1oXgn2zfXkBYZ6XiQ4q2gXhPVX0Yb+EJWUd1qNt8O6jm3NG9NqlQ2GsAbTeCMc
+UYIVXfTJfWoNzaJfTDQvwXF4GVteSnfm5VqC0CvacvKw1VhKCDwVSL52CfLb7XY+x8vzYmRD+llk
+XLUFQJQjda1Zfw1LwAr2FALi/3IKYrbEvNnMofVMNe+wVoLD4BbB+rFUJVkrcsQKColop0TqAQdR
+6hVjulrQ2efirNjEwy76e2BBQultt+5hov5IHmCI0YDlW6kQD/AsNew8XrMCkWFrOjhSaER7n1m/
+9ydSEMqRG59qvDgIf9XE2wRpKgUzJGqIEIOV6HM9RkZQ4lU/oMHtkpXdyqp12OzY1l76GMqYZ2fY
+AAeaJmXtNc/WIzAsDuyAWKxLj2U2ucXUjVgJJXaOpUgpAIBHobm8yE1lIcusrS/F9FmZpphaD5Rj
+i1707Ga5N1Ojthc41YfN9L62xx4crrgUIh7y2DNFR5wBrk4FXDifW3va5vO3aBoTYVLQ4luDLNjB
++mj+PhIRtX3Fd//YIf8j4cX0S3mApo4tNOaItgViAbHICgKbOt1SX5jnQKzaYfZYUMp7DIgjE3lv
+MRXn9IDcuVSytZigA8TGZ2wULFmp4q3X6rwY8piml040irjRXPIHq3DY0mdtvCWG/gIVkllsZj2W
+0iEaa81Da25oFadRa6cUDraMR61tpQ1PU6mOYp91+WzJI8Pyq/ixnlnwukNht0PQtrHVp5ztqRia
+azWM9lqrAOwr9F3VrFpRijxWpdurSqTPbcJUW6m2IFi+kgotoHSGYMkNlBBItvTBu5+xIFAQDmqH
+LLHDnsf9NYVUNxVrr9C1Ql0lJxMOJL7hWCDiaMBJ1fD1G4X4YE1FbB20iIZkOVrWJ6G1QatwDSZA
+VRCG2vJWtmqY2c4aD7HU/+EBOPkeotnSIC/PdFh+PobejndbN1MfT69hfYouz+eteUxoErLcjcOr
+U2xpItbDAd+H8p/ExiTmHDm+iR3WCKYExevCSG4oE6qDDKlVQIFiEPB2Cx132LIQhAdAvxaP59r/
+0+l65587xEhzuiNxjji0XcFHcrWb51j0S7PF/Fu9GMcaFxCGeGBQDgddY6uf8dpKlk7hjZAUgJg/
+l27NRyrSzXFsqbgfxGu3wRYSLLPYWiHaN2hVnZ2Yx1pt+8FjjhfBNImtczzviIIeHMznsJ8FMYhL
+UvE92sp1w1rnHXGcWXeR+f77737oHPQFAkRFUwvt8vCc1EhNUk0PJ4mdVVf2xioVQeoYW8DOGipd
+YKdeQKVt1gtuzdy8tQaTHdYaFILMOX/3A4CPiklhxRZ451iK3Sr4Uls3L1cCbLDFEB1bd9BInZio
+GLImmgp22JNs1rILlNxVgAWR2wCCWpt18bTZIotd7YHf09KSd8S9CMYjFUP80tG/a80nLnW+nAFf
+ia1WZbXKAKRHz16cBoKeY3HGuZYbCQpYry8NKAjLZPqo31ZP3NhtiWjDWuE08bMCAUuRd9ZqxvJ9
+LpVLnP/iPateba0aFIv3szPxpXvb5YpXI9X7u9oJ6ohtbD1HAnhI4bJfIdXQjChgG8WOy7POP0uD
+w97ktY6cVhKZvL3n0RPnQ5XF0pKapa+tvhB07TN70qscYYVPdFwQHo39flCyrYeYXyv3KMX/1o6+
+q7wEEuwknrTYsfLToY8TJh3QBe94pFMUTBJbTCRn447DU48K7y71Y5G00HF+8l8W+QMLR6Ef2XqN
+zlp5wDU9YUsS0jVKb2iulRepbRBnQMDDbUoUW1VtbdAb99ioASwj7xh06BPvzjlWn/mhOAO67DGG
+rWzzt56u/90OkUgXEE+kLVg3LMgaA96KEpY7VpR5p9tZj7/pDpVra+MEK2FrwWzdFqsCkBylMObu
+WncRq4txtnIK2GYrBm4EKVFvsPoyvqPKBKvtCLhyIUup0RlXEn1rnbdSFZ9iZu0s0mo8TJ21uHdY
+C9MWrzI91myVlaDXD9izENiSeo5EVmAqYhXkq2ZrzvmLY2+OSUBOPOpiyXZsqq23aKTIPkbGO0To
+xvBIN4QVghLQeXeOisieSTHOY7sqwJ4J7fnYmpgRdwPPYBM5gttmBL7aSnSG3OfHZF36CWl2g34+
+YcXhKNy85rl0XaILWyTUYTl+Hp1amNYqt+htqFKaoXvrkwWb/TUNaljGPzk5IlwecNLtCrRGpxPo
+USxhuvocue10/Z/WQx1aROzYGmpUNhyOrbNneLkyd4Vn3mqtEKjTZUmKYEsOIdlyA0u2gRFILjAx
+wLS8EjrvyLmaXGAiJTrxe63d8m+hJMgHioEb/qm980IuGwH0s/Bgc3Wfs6W6r09uM8nLrUiOshVU
+8zBUiFgsPbd1i0+uk/RJ1SQFY/O2T0dUpNNta7dupK3Dm+lsYUBbYIpOMgYg8TpMRxlbs9STAoKx
+rc3f4lAJR1JllYi6aOmF+jGkx9eh24NLTnVlzw8HLawYLIsXlmdd4OJC3MP7Pq0vx7EnxN2ipIAg
+Utr22jfiP1OyRc42r+LH4u7dp71OW/3W+dzGKO0hXW90KEliPyKW4hFiLAoW/M7Sid05ufaEhaDs
+YTDRr5KZiJAV9CvCWG49cLBYFSJ/3ovlsKYZeDJNDOSSKdbQbVGKCAK/QPZEYqTZQmlGiC+kwwzs
+XyFs4sGKLyDR88WpvZuHszBexTO3wRbPpD+yim5snIdDYqBSoDXBI1eeOxt3LcyAxax+LybXRkMN
+WwUrGbq5ZmtPmnarRnfEEQasHFvvkVcwcGKJmIMiILAPWjRd63eMHYOME4AVWFOPl1VBo+2W/+Us
+T+fkigflMMkjqhkiKYd3xfASjdAeBscVK8HSglzBEGuJZSMUA0VamNYjwY+YmJYbdr+wRwZ+aMsB
+R7IYf2dJ1vXmC3usKkUmVSjHCbQDRDtCZhG02XK9NSIruR5XNJ6ty/kdYT8rAUoNVfCgRIbkre/f
+kUphG+TeplxPyhr6uR6nlw5lOi0GRAVA+0JbQX9i/wh9WfAYwgaTtqdyjmOPI+gyAVltyO388vMd
+kQ04FD5nXISYOc5/2bANW1OQh8gSTxRR/Q5aCN1pYVrirQlqkSFhBQYVfR7M6iEeFs+XpZ71okWh
+waW+r7edIVywb6PElgqTWmHWLt5cEl9MdYcNIcmv1aG2ZV8lWKHMA1ieOzLokLLOlV5Et/GkwrEh
+XIwdMDQVylLMkPFZnABkg42F6y5YPx8NKWjM7m6HbpUuS64tUm6UZ1lyVCvMuFZ8NC8YPQqp2KE+
+89aHyDMsWjag32IU2wKxjq1ViGnLPWORlZG26F+eclBITCK0dlhvvbGVgDlozIZECo4mysKOWnHP
+vSMJk058zMie/2FhWZSRtAv4JyUMGXoiMM0eW31TsPAAOJhXPXxqwbotAb5ROXKGw8exFF9I/T9H
+bgDRMW99Z9lsdq1+r4s7GInffIMtYmxRhYgazLXNMuAeqXspSyuwIuZWWSvpG1v/eqPEWUjYqR95
+G+lzt+e31q8mgYNyuL4W48nalQSutW02ECWRc8Nav8UaooLXtZCbsCuKoVdRGKYsWhrssAGO4uxn
+QpFQaxQLkKDXBouK5hvxoRvLfWW2RF6bjWs2X7o8KuNlDTTSLAc49HzhgZ3W66Dp1CNiMWWeq/Ge
+toAnd4F1M+HPbj+RmBUGWA1QTtjQUlEoa8UNjSW6Fmuk5X2ajtCD1vSyevMb5fDSMYChfpa8t6FQ
+G4moMbFvLIHKZWG8f4QrsNFG20HBysIUhPEqcocc1KfUgXfsGZ9DPhM1YYiNbQ1lx9KZETQR12yR
+dOPYQ6I9Nn4JQoZbZ2PIvaLcesiDdXKHHMP7IaP0AFSooOkxsy5xXmfExWKJX1g+9mBaYoKCQG7Y
+c5Qt3unYit95dk0N9pkSXMEj4TT5BAsIKhDrulDj8Zq1enga5vlSOLogYUw1MX0CZfWYkVqXiSGP
+lUge9L6GsS6m5ve60k731718ptcek9mymEbOaEGOsgJ5Sgu8z560b+R1kDyHTaVepLBTEFjw1G7l
+yjff2Lqsn6gYIs9rlzuXrIwTTEnvKmO9GHDBMvNAh/01bJ76mhlwnpYDzXUt2xJW/lVyhJ3ae7Vq
+G3lPdEBjC3/letaqYMpti44CXKc3HNTDhZUK9ti8tYBtEQIOq6I637XY+CPeB81JbxRmpPaid5SW
+2t95/7TmjrtKaOIT1lqmJJKghbW6kt9ZomUcHX0eRYlN5YLwjnLMDyOr2LczIGmQsThJzBxHXrKF
+lRVdygFU6Ljm2kfx14aRLl3UTgTWrEvrmzYbJVa2nj2Ww8RbaH3KHYyaBwi8jo3+3URsGWpt2kty
+imF8G1xWtCphe1oEuIpsec4ucb5DXsfc/2iHPTIQK8cPbQ3QteBxUZ8A3rBuBwD2bXV85wdeosUT
+NocdyY06KuDRWcjwX8rB9iTltY8sZsuD8F6wXkXif3kM2OZ0Kacc2+sWoKydL77c69hy+htlTxSE
+X+zYmvl00ydWw4opua7FKvt82XKHjJCnYD6PZ3JkzlxPVAB+YvlinmhHOaqHoi+KHWu0i8FaMMSk
+mrsfiiVP2QzSVywin+i/8jYvHf8Pfe/vOIRTWG2GIEj4L+W0Y2yUHkZKoPEXG4xq8yXW1LVT8OXu
+SlGw3TkshswoEbv6K0m32GgrQDu2gLZj41EG5R5YUCf9JsqpWp9VZAV8m93ATht6ZIupO7Yqso2v
+0INVq4vlgiZHyp5j0sqReIoNvii3NcdCpz371uH+GoOTxDFBeUXy+c4eC3GUqlrDMMhX9OyIV50+
++xa5aI06UHzp/zvt6NiyQ4nP0FES6fCHP9hoVeFMSNKu3h1ffbpn71c2gMVWW2+XoPNo3/bap96G
+3D5JADUbB+0xJ8ZihCiLDrjMluV3LEA1ztd06MrZYVlJOXLskWxxocby2jxQswcd1rD12m0hfns9
+VzgQBcQoeANP+ZRl2II9vv/UHsOLx7gU8cAeA7Vs5Ng4si7rs1CdLAvd1W7LoBuJr1KJRQ42BSDW
+jq0BLvzdXlaO2OxVG137CfVRNz1kXGfmRQMJ/E1JyF/3P//VuaGY2trkpr1H3OF0vUGjfmrXRfih
+6x17AGQcGxKyXft9ElkFJfjqmmfD9iUjW6cQqP2Q0SWl/QAmCoQj/raON0VTS/VpZj/g/Ym9BKbC
+1GA3qr66XpLbKGFyMEhaLlSJ3CTlFVSphAizC5K/NFD2LgcmtZt/Z8Qrnn2+us/4CddXxn6nbA5V
+ZNPxWaWd6b427kKFrxbwXKSY++0xS5ixuhUMosBLH6nZbEp8ZG+Sgj3cJzaRYOJytJZvLllFXsRy
+6iXGiMqlG0wl5xV+vJ2ROH/AhqtynNGrBV7FHInuiIxNbYpskpC9wkmyTmIWoOe1Tn6EAfGWpIrk
+uEc2Y9xo6wySa7WY94Kliml96lezUSJhOrwyyvNLkVxJ4ck+oDNxDfhKWWccVoLZU6WUDvzYFgAw
+a7UF+JevxcznhURM5ILwezWVpLxIKeS9TMw65w1IFL19UVH0ipJsK5638TslEXIQ0pLhbrBgDIbG
+6P1Xj0MJm0okXxYDtEn59soDw1t/XFYvcF22hO2DqcdycZfcn8uoRF7qE7+6Tlsn9tcqE5jK2r4z
+S38KOA90Eyq75FKUAWPh7UI+77aqMHOcEufVQihXhEVyIViF14RhWZVUBJB4f8ZayG3ZFXttM20x
+ntbzMJ1/E+QzLgs8qYCj1tKQeGmmnADypItOF7mjOOYdovaWgN9vstH7bNqNeOSxH3SiUkpyqxI5
+2fcYglNbS0Q0a1MO1hLpzP42iXbYupZ2A5xQZO6NtskLCe/HYto9K64nIt9syohZy9bxiF/5oATu
+ynx9ydui/4mOR0aRkZ6ZWcVQdBtHpgIa90SfNuhIx2u5XECPRhLoaMuDOgJe30ysv5a7I8KO6nQt
+l85IMgGBRliTdCVVMBJdKTl6PNcdsLdxw5hX+xk7JhEUjC+0x/Ti9cSLB6xsc7rWzpJpgDM2g8cP
+DERLopKIwrWkHK8gtwexHv2gWBpr+yaeNjryzFp20mdr+2y9O8ybtPltcus13w95yxiJCjiQgTO/
+JxZYhR3U5GCdGTRyu2tMtlBUBkUu9wCzWbS4byUqVG6mkRj7hGlG/z9bb9ok13leCcq7Ud3ofV+v
+U+1mYVyoAuTpCTeqUA4QJEXIJMUQIKtnPD2KW5W3qi6ZlVmdN7OApKQIECS4iRRlW6RkmTZFESQh
+CABBgAABcPsA+6uC/GQw5os7gqSoiZnfMPOcc57nvW+iRwuqKpd73/uuz3Kec9A2kFUgTqdk7Cpk
+InlcsFqupIIUy5+8urPp21ZEJnqHokGhzEYsKsW6SqF0lrcgjWIvMM7I+lOMrs1TGPj2fdt2qBwI
+fx9mEVVah5S43MT4A2qK6BysfswDYvFtbkZ1I4IAduURsoiYj3wue2bPz00I37IhlLZB1d2vOhDE
+NmcR11AOBV6FtBEwn8eY+l2iHCZlPVyFeqxCVZxvRJWhcgbISK+eahxo3t0YNERNALCD9gPkCjes
+3NrCObDhRZ3Shllesk1pzDCZCgY6he/SPW2iZl14EaLH4CeEqa/ZhyoWnq6Llx9wbMSYV0dCitl9
+1+vdK3V/wQvOYh1qurG+SMaIt9vWCw4bmwDHrO8RICLeDaKjXTwkloNE3lZX2TX3+joBYIALjBon
+/XUP8C07Gr+7r4jC0xHl2IHgxwI6HPuHVAGGiKyz/hNKWuujDdU+2zxnGJFAVO6jqKtF2EKFuqsT
+ysWD3h4L1T5JYdK1yvdVzk/GKjYgfICeRORY63/Qw0CrOqyLeWsrdqSATKcQQhqixRMmbbxUqJEi
+T6ia9CYAgGC9Ktdo+xwQeVuolTMvdYStg/OjS2V0Irq0T/8HwZvmHYW0urxkBufSCDUw3I9U6lVB
+0JmhNrYWJd6OuqV4oT373dZ5sMy8lrrxAu0GwUxEKQWZwDhuDrCfR904AgJ2ilZKjnWWYY3BFVLp
+a11+TXUzReGlivf4viGOodURD2uqdmhfg9cDu9kDfTNe49vQPjcLkbVSkPbrq9xJygTWdYzB9xwd
+D5wlDe6G550qArlffCX2f9XJdL1su0FeB/vBSm37HvIrUAWr1oW1qoFeIpQbUAWmf/2cmTChY/Pu
+fhlqPQJGUHBO+02ry+ZWV/ooC8te592wvGSmKByy1rh63SQq3xxB3pVWF9a/UKgAh2Kft1VmHqjt
+X3Y3PKPmjc03Mw6EwGO4fpkusI0MJdv5P+yYZvFg0K3THOW2cI9KNwrpSHWWO4X0l4pZrTtoNuJ+
+HpWd4fk9XEW40jqrLnV+YBBq7pOLXmcHkxu6f7QasK6BCgFKw9w+bOVebCztkH3F51Wq1JClgDEp
+23/1POMeMllLXjnimAOAqHgO00+yn0u9mvOaZU02dNTtZuEdAler1ZIie8uaf4gvChYqlEO1CwFc
+nHsOlC42JX04WoWHafd2HNk+rj87jx0p0OnVD3GGeRix2OVuoxdbdaWbMzPDxWYLh+rHm5Bz73Ne
+OItA47B5hhOwJ+GcRLWPRxQnOItx3j8w7rLi0Uuw55GGxnUKX8CqcYfiHkHyHbhNpblJEnqjtNuI
+5Rk8P6GBVPKcmi8UFu3WBKsUKn1YrXbL/NvdKVS56sAoFhFi+3PonjsJqn4kUKqSu8h9xhakVyWD
+cYqGocyf3mRfcRtzgLcJDHQbnzZU4bDPEouGmjLWbhQ6V9cmd5kPgzOfQWAboajicRR04+WzfaIa
+CPDf5vz4Ggqay80o7Bp+3dkxtA+sTpw/AIEKruy1XnUMduVBt5N75dEh55PauyzdSSpccbyLrGQW
+68mcVkzjZaezMDd9E1CYvhNFFNTxwgmg+sRmwYMCC24Ai84C+85wNF73wqT9nQ7nof1xFLszcRnc
+aLtUBLP1o6xnp/B9tIvdtVHZH/fF/wi16HprEdXyd9izddxLh+8AnWrh2ToF5hZmkJAtKIs0C1yq
+j9z3MFwI3TrPQ8NS9EHdOKxxZodzijgRhx0etjpsn0VICfsbylVpAzP3QMVGhihVhcJ1sAVHb39H
+8J07HSTjdmIj9cxOUQ29OrzrMc7J5hbQ3yAtQRQPDhvqU/x8WaKaHw4YAXsbJxGZh+4ZYiyF4kv7
+gzxF20dned4ByNr3wAJSqxJu3FvHETXvaShvf9dL2Asvnm3uVP0jVI4wD5sv+z7fcZC8lht1LYeI
+izrjSVO6HcQaSWvgHW430UG1B5gvVOw+IaKht+YwpcJMso3avBbn6ChYMbRSw9OHHzdhtpT1/toP
+kQ1D0FS7GKoU+fyFY48bBwoXXkdW7f693/tP/3n33g4qJ+BLuvM+LIKrg8qN+/Z4BmEEHwlz2QlB
+mt1eg8QQgPWjY30KunHteW/TBkFxVLsw39Lx/W3ez4uulyo366hoNH90ZuYAAQrQQMI+xuDREkgA
+VgfdhNfsLCO6hHPJCWYA3B5ho3nAJjeCaARl23M4iH0e5CSs5bR9HPuoVys2Kpac2aHiHcwLse14
+NH5xNBAyY2mBOOrlwYM9xANKL35DPI5V3Xu81NQZEJqv+Lx17otuMXvUNrqNYr7Q88EOwT5Ge6ot
+th46xUrh86nwAouOE1YUzq+CIA3tw0WnJFj04qxAQ2hfK3tzPv8CQT+v0omOeRcsuVn28B5QAqu2
+uwP1Bm6K0YbNR3uQLh2apvqPML3tdubTEZZH8Vq7P1cb2y9iFAeTBpqmYLPK7sBLXzqIY5id01vy
+dLjXEi4teRLl66CM2Wq+6dQhjZNHxLQsvLKzoOx2H/a98pxLK4pP9rwcqvANt/Cqea9fbpYUSy2C
+Z8GZWFAWaHu8QFmwq+Tf9ya7nIxiVB5jaQXKQPC+t3vGOSca7PLjG2caaTMfGRTuqGp+7Zwxswn6
+qhPbNa0L64ZetdnRazbJJ0BoDoa97m1AN/W6qNTw4dof9Djub29uHVsE6Ht34XXZTvrT+PN0t0vZ
+63ZubG5RBVnxAaR5WFSl8vHYJvfwPpzfQkcveUHlwYjvIICyVh+b8YXix1ux5Bu+02Q0zI8BNi86
+mKJww8rNm4Il5rZvM/0DagNng4laRGbCMI/E+7Me1e6Cddh3nEapsJ43m2BZdhDyuNLfw6KhfaTi
+UsUl0FRNy8b3rXmpdh6iNqn1/wROQbxv9+44X9IuZknqpnBWj4b9ZOPk1AOYN+aj9OH02mZvM1LY
+oP3Y6WAjPlAi44ssEPhRGudQ6Syvl5u6ocqWC2RLsN58PhVIqhP9il2IcaWtfXu3jhXz/gGvVmkc
+a/yFXvnQBDtrH3E26yD2L+tfuA10aO3YeS6g1tKCu5v+/r4vLHigz7nDCvdbC2W2O4UT3ng62nYK
+h5E5iczMrKTgds1KDG7Xhhk3o+bBuna7cTjYvPHj/l89MrrxzvDBG69VYIADsxtDpDd+0idKzMxK
+D+K7djc00xkJb/qILGOC0w6rBz6OA4/2x7oo1wcrNRwkD+M3HuCGih4MqxL7EM5PhGzGN35i16e9
+je9PyJCqbhhs3bgOt9zWejMAb6jsC5y73CcQN+0h7ECNxD7iUQhGjxD1pn+ByAa4aD1ehoaMamud
+JxQa2ls3LtHPMM/UjClG5Jty88ZPYPhuM1piGwPYh7qkK92io8aC1XLoRZiDLRSsoTIE97XnYdIC
+YVBf4J5saPg9cOneuDTsmmvyELIog4fKSIwIgEsVURtYMfygfWXPThyAn5Qy0XZs4+RxXypr4nP+
+d+lJg8b7ryR3bL09KPGHtZGcxvZc+IO8qMpDwI8H50wkK8wuJPIEuI4BwuwlOrFfl4hL4n6e4rC9
+epWMuqW1ukcF7JrvryHKgtcZgYddE/4GQ0WNCgw47sjeNCslw1tVMHLJDyuHHo8ZKEqDv+FZav7B
+wHvgxqVtJAwUfxsNRjd+sgoDw95/AETJ1JS39isdVcJ1HcN9QgIEDVB1KooXrPdunEOSYJv9Ix47
+/x7mETM7iLsOMJ9JzgF15Iqlp9gT8IvNzxuXzIEZINLDfmN5EUThscrgX4md13Yk9hPHw/ppsKX+
+Zx6hxv1p7w5QTLlt14EaJK4HsEq9Ug89LljSruG6IkC2LDVN7VM3ziEQ2b1xCWWUzo+BdceMCjip
+b5wbIPW9Cs4bW7nYd83ugb1sDgL6ZwWRGDA7AXbO4n07D0uNP/wu9CPFzIfrpcqzBzTOMLsY7wT6
+UOPhmZxz5hxsk2eYNGAPgUYalFEjxrtZ/adEE9ApN86BHZhM2RWX7/rYPr+Nr1m7bJmC8TjYfRt7
+JjsbHmJ+5sYbJfIjgDt7AkrxtoonAZVRPb/YqGTS1qUtXsCl9RyAHIn/WSW/lee6bB6of0pn373w
+weUPHwaTLX5+eOLDpxMPsP3E384m/M4Hz+lzibkXfLxg0yXP8Icn/XPXeE1wC+s6V3UfMN/y8349
++7/ev0Cm4TfsOmjNVfsE/8Znydj7Hvl4r1lLHnUW4nfFaUxW4PesPfp5DXf68DjabH/h/uI/fkfv
+f/gdtv0Jv/9VsAM7a/KbzgEs1t4LzvN7gW1/g/ck77B9A4zAz4LRF3/xqU+AcdmujZbj+d7mNfD9
+y/yePZe3z9qjz5BDGe1nO9A+vEIGZNzn2x8+xn76NkaDrQB/8JvWbjEnX+bV3uRzqp8u2u9PeH+9
+w/G5as92wRmbHyEL8gX7FtmK7bf3+MQXfFy+/cFb5Gf+DvmKL2GcyHp8yfvvmrf6BNml37XP6Pvv
++fNe8J+YPcfZo1ftWpwXfO1pzBvOIzFFk7PZ2nuVPLCPig/25hmws350krywYoz9CV+/QEbUR8l+
+e9ZO+6fEbnvzx2LXhQUALleyxV7+6GHnfG1ZaPHNp+zdJ+yVN8HBau+LS1ecs6/x20+AZ/bmy/bu
+T+2K58m4q/+fI3ssrvIoOXqfAFfrzSs3r998y9rM6zoD8CN8B/fHVV8nA/Cj9qnT3v5Hbr5Jdllw
+BZ8Ex64z0b4GXl20y957FRy9aKn9fIvMsuCLJZuu2o9WW4vF4vuGtfykP+/DYLD96AS5eU/yehed
+lfcnYMi1n687Y+1T7FGw4Yrj+CdgksV3ybaLb55Ff9hnXyZH8ZPOIPwa733Oeuga++GK/fWm3+OS
+fedR9Sd4fPnt18m9+5p4b+1uPwkeXrLzXhFnMsf0Yd3fuXrP2PsP37zC66L3zrNvMS6nvX2v6X2y
+E4PHmDy95N9Fn5+UVWij9bL37+vkbH7Nx/QxPA/5m89wZE6Sf/g8ftO4fM7/80v+31/2//6q//dz
+v/TLv/Krv/brv/Ebv/5rv/orv/xLn/vNHTN/5+/u/Ht//+//vZ1/9+/M7PjNf/AP/9E//if/9J/9
+83/+z/7pP/nH/+gf/oN/8S//1b/+N//23/37f//v/u2/+df/6l/+i//X/hP3wO+/ZD9/2f//S/7/
+X+F78af/9pv+38+xUb9iTfm1z/36534j4lqKsqICJTLkkk9QrHhzExVAEzt86h6iLCRXguF+x5E7
+Fo6Rg4mQMnMUUKaJek8oHVSjjbY6uxoq5kViqRUe4+bJuVuP4DVAssNOOMzmZzDmvExqDhjxncKr
+dey8Histw6QlIHoDD2spbFH3JvLnxNnbQ0yochIK0OajQhCcYJ5jLuKXRrzCYIMdDcdM9AP0wgx+
+JzJRW6jUR5kQQORqhueQnVnNPEBULzFSmOAT1TEAOsDwi5g2M2nyXHbOEJ2N7yOlQeKt2+sB80aT
+bwXwMtJvDTFtcKAPuGPZBJKi6cKRRSy7KckSM2qTo840YBYMcVZmTBC3AEi/ObY9RmSdDWtpQVyf
+oC5U7NFuw6SmtdZGzJqB9C9ewaUEnXqo6sYtuqSs66NuzJ6FmLrRhvnMfZs693kyrZhfs5Y1s7sW
+Yb/zcTbrdY1X2YdRsxoUfeYyijGFpK4Nq2lAzQf3c7sSkg3uP4IpSDwidYDE4ETxxDpFysE7abMH
+sWnlqOtmk1w1+ExFlXUz1w70qmMM3Zdbirx1A4jSELeN0JSzo9lEWltDrBLJLYXZl1nBivcFLrZf
+FiLQiPIeJh8cbbu/sxKjzMzdUdD8sW7GPgMo543Xyrq52yb25OhggPxdKY/bgTiNLRAS/MywmgG3
+v8NsWfjeKJEjiqF70NwPFtOAMw4Xbm53nA8Cf2tjXHB5xkPs4VoG3xFABhVpxQpGhVD2TjdvTar2
+jgWuEHPYQrmqMhUzAXloWGWDwfX8QtkT+S1pAx0H4OUWnQUH5VfdO2NZbaEC6lB/NAs5FQaVx32v
+J7V7sckzSlNaM+6zyULsYMXEK+irxaRXdUdHBwUYGxoVxYyEFWTlulMdd5ZZQ94vUZajPGf3diKW
+WRU9VIp+xIQgyuThMyBITB5S1BbG0zR3wplasRssOVhyORLl9t1mTIRu1KMuqiDcrvPFymcCXCGm
+f81Nsosz777JwqsmgFHzdZ9YAtKfCQN0MPZnBy+Nm0DZkM4F5H9V4J0aFkZgOPsClKNgdl1Em5w1
+ot1lqfwK4M1M10ycEq43QXUnxzQSxItCCjhESek10WXbvmH7OnlT8AXO8poZK7uyeDhByYDVhhAt
+uZ7wqAAUbm0MQEVZbVrnbVUBDmqIagKMYlfEm0HJxysrIUc+qc3BKlJxLPrn9Ds20nbRjD0r6NF1
+pPGVhmzSGeD3Qvlg3eWMsjajumMleMpt47YtTlFMz1J3A17VRP58phk3yrh7BqPY02zVQ5xcvciF
+z2zWoO0wT01ZcusxZ9dAPBlVszaBRGFmjfeA1wNka6tAO+40zNh7BXLqYtPnju2kWmSv1YARAIEH
+FDk1Uvpgj4milTGO/i95sLAg0p6UDh5+7Za9dZy0G5sBcGqASSG5s7Dh/Yq5kfFQYC0sg8i+KI0u
+PIyXpBHYp8zTbEBFBE2TwSDy/F2MSq5uFIEW6e6OvOhd1coQrIZFJHCLyCTuI+QBm50tQOa6cfii
+gg6WTA+Riv0dzcxVMLmWOoAOOGCukD0EdoNjXmp8NzrjD/BPZI0DZVNsEqBgRwnhOyRnXVvTZIvI
+5A4PsZPHg8fxvM1ZJ0tjgW2JIsWBdfvRjUHgFD0w1KhOpYaTrlQlc5FCQWICbGNP64rttwYkwAOy
+807QMutUdkCCIHNhLcQ6I+ViwjzhEww/RRauC1JgGhy7Y4us+hgjAOF7Pjcc4gaMwrJIpyJpXji3
+bNW9z8PBRZxWS2sAN8CyOuQIwKIY9Fd646HZdWNml/mA9Za4QBpykRX3Wv+xiqZcGcjMcHZfry8E
+bL1CF8C8EKW07Vp9kB8Q5dfdJhtls3XMgf+rvjF2zSjd3zF7puMIO0pUiNS2CHCkq1wMhoEWazzd
+V3UPA72HJyy7XSakZmOOFB6ir1jaQi4kN0rBHW3Pge39cGzv4C6ybqFo1ojsbsHq1nVo17D5EsLq
+eDzwHrBQRdsXuLU8neo1cdZaAJ4RWLJHWalBHkIoyDpstaNkpAYPx7FFJ3LcqEB+DwYmwkXHw8i+
+VZHg69oxUfbF2+BbE3J3NXCgVCFAe1SJbCviDof1THzvpbACCI/MMBOBpZnohKQR8yRsVqPCLlTn
+O4p3PrbTpTWi8UCVgdUNQijQjzckliJBfo3Ce9QH2RFUBGqGqBwQnvDEgkEOi68UsFRdWIz7zgFo
+6/RBEm10Cqevi2RXJXoWckpvVmSeCtzSZAU7J1IdZeHc5xwdzErsAP3R8tLCfYPhUTtkYTITXcRh
+si3OcbXwToazgaSAPQ9k9sQ5gEHONJKVTOgW0Q1+3My7WgI2fHk3Q5tY2xw4ICg0yuYysJZRtZSg
+qxdhJyqYVwTTKaWsYIuXp5Z95kB7BBApBHk+hwIeqIc4d22Ssc4NdZlipdiuUHCODq6crKEekevF
+M1s4wUQaC9qLHre4LpQ4hoOjZIfi7o6yLFFM2HwL/G2zOeiBMqVqiMG3PYy2KC1x0GuywpLg8w2z
+llkuR76Fyjc0UqNvYRcm2yjwCCwgYtWWILyqIAMDCJP6GGXuLbhOP8xL4TXtYnfGuUwCNUx8yvs1
+mqKbdCLuF7i87JEkHeOPPXMVFpo5qiAdHsKkET5C1FaAy/zWHx0kB2Rn2VEYhx1cVjjLgm2VFc5r
++9Z8cYgcSsi1qdNU8DBCClZQwnlhoASKIiixcVLs3mR3OKiy2Wj8j5wYx8Z/t2A2a25kI1eITliv
+RgdZTUqvH+O1tKDE4jJLoDa92LkWJacs6kZMAzQdgQWmqcb9vj8iyyHSe4Il1dXRr9VuvJn9pycV
+H4OdEXhasn38oW16D41tL50VgUNvQpsM7rTSd/DrhMcEe8+2bWsFGPW2NW2+Vj9Yb2G2yroguESU
+ahNZHUdRKGLNx0kfeWgHkME+FFdzOWICjacM2ZGGKOnsid7Hq91p15Gat6uaK9hjlfMFLkdK9BDt
+MTzCEdGestiVm5QXKG02LObCgXEXdh07bElMwdOcNjb2KYTs2TD0iihyMWsxpkfC7FF5A3KK2IQx
+e/q2768DMeQJddsctlADBkwk2dxJCdxT5aeYwUH0H+c6WtwFeZ+mIvaN0kssnVUF9O5Kghfi9QSd
+hn2am35ZOAd4D/tYQ7okhmYGa1/2SVs49ZGtZfp3tXScNnFwmJnb4zonfKWhWeJqKOTDIKc9hQNs
+Ln0jsJgqpSZL37ru5ZJOSNwyIWn+4Oa6ADXkmgNlF+xvcE9W0p/h1yNJjpN8VG5uQWCDey8G5YtD
+0vKul/SSUNgs9Q0QknSLElWDGLFBnxS4GDh862vw0bwCnLao8MhHNmifD0MpCggkFCYDKu8Q2kY8
+bPatg2H7xXN1nbS6wlqsSNm/FMhGR7btnHE3bhUIOR0lAUpYLlmob78XRUg8OM4UOJA12ki7A8Lu
+lIugkpqIBYXcOwPrOpqmODTFOYydUdXmYAEYstSx+Wp4Cu4B2T6/zgq0UUUeACxRVCjqkMSmjos7
+V/pcAEA6y34vQscV1qtE+mhLmIIW3BOANhAnI7xKs3KRB4YNe3ADFGA1WDtUOWAzlQg/cLQ1NRYz
+kvZ69pmAKSiGRgIJD9ORQKY0A4NxJkYhSDGxDQccvOl2QbAwPgg1A6xpFIeE4IBZuaA3A8i9DOjM
+ktkRPXA8qIWb4NFDANc2mZkZpy52Lr3RQGU5CF553USzHBVEYQk7tFtSUeMtjgVSfxgmKtrC7i4d
+uNdjya9vO2DCOuoaWzR3naXqoI3sykqFLvB9I6pEmqOIiphd2I9IRZTS7iOX3griG6JGt284gX2x
+FwYVgoLLbmn1JuKwxZzEzo9jOwDcRVS0scqYTY24SyGuKnDZVLavoFebCA3ZnjU8sC6AtMI+YpDf
+2jBLLWpJbM6uA2UURRhza2a7McREgwHWR+CUlkBCxW0Qa4cadiIsgMVIg+HBCsUAZkQjogi7BRt+
+RHvmxHNkD3iYEl86BxE0N5uElVywgOMQD/C0WTIageWRMzihDFoLIgC4TfhE3SNhr4JUj1tlDMp+
+sVkAUoyjH0aOU6aCbaUh1D9VGtgtPEoUMepJAuWucssEiaGHiKyjun3Wt2PgyJ5VRKlfDPcXWGOP
+65RFq0KmOJt7x4OhaKSAjgG5oU3wItINYDMhRGtGdt2ouyx7oyD1NVGyM2ZbrLp1AeZT1JVHEGwE
+kjvILMgYwMEWZ3aApJaWnLlhWfXZ1obA6fQDaL0cp80eElxwe/cavTkGvlHBzEOcXo0Z5KTLlXoX
+di0vqJxjPFo0jYJOFxGD3ee0173JQR6fdlLggqLfRXU7+gjTj0ct3qL5LYigGNjFjAEeniYNN8Lt
+4dFDs0RuiJNNb1T32lIZb5IsGFRXtspnIy62CeGCZjAqt6IMsyx8xO5AcgBXFH00T0+XpJBgizUR
+rMrfxHHEYnQZVHYzbE+dguaG2SSw8tAbjmetU0VR4+QPq4OIeRZRHFSSG0GlRmACsklZshqeuhEO
+uXRO03rExQpsXBHg+1gXhdhobIYIHu+4Rpq7jIVj3+UEQAt3RoB9vxNlzncr2dVVoM4LBROwS9h1
+qIWztOAVJ96pmuEgJqlYg4c22NLX9ykUcfeRe+8hLQR818k+5+4+uIHgoO+QPEzIRIYMXrgDxe5A
+r/pBb65v1O+h5IalNWWcTcTGobh6LZIUPZRtVX/90tiOsdF4u/7rS3/1tP0Jwey/esZ/+dtrj/7t
+1e//7dUff/r9Vz594exnZ/5SgL1Pzn1fiD375f957oX/6/K5//v5Nz++ev3jt49/fO2Jz86/9PPz
+34dW/dnvfXb+Ar54+juf/fT5T545/ukLz3zy1Euf/Nnpj6//yWcPf+/n7/7xp0+8/8kLFz6+/trH
+77/x8bXv/uKR0588fv3nTz7x81PvffqdP/nspac/ffUFe/Gz77776Y/e+ez9P//46vFPz73y6fde
+//jtH3xy/i9+cebpz15/+Oc/eviT1773yR//4ON3fvjpcxc+feb8J9f/9NMrT3z25rXPjp/85Km/
+/OTk2U/On/rs/OnPzl//5Onrn771xicvXPrk6olP/+Tcp997z9rzydPf//j6WXvlk1OvfXLu1Z9/
+78XPrjz16V+8Yv/+3B7nu4/h4u+/9OnDr1ubA07Y2Ny7cc58hgFMN0zkcqUEbBsZkjFBcNXQMWDd
+BBI0+6onmBNsLeRtbYa7U2NLekyE18qN68jpsvpcaCEqtZMlQN4NgrFCDzr8tO4OwngbboeTlZCE
+AF4B1EeaFIKh+jBmWZuuc7mFMyZcZFN7gGugymhC71bHhN+p1vnGubLevPES7ItAyZbDgFcNmXMB
+0hLl9rypmXE3frKGKn1IZDkmSyAyFdPqFsTJYaa+1INWvdef26brAKrGI2Z1ads/UhVl4zXbg8ZB
+WaFaaz1vjbnx0upIdSUERnof1o4ks1fQy+xVLBw2NZ7CUd6EqxHGV/a87oXMC/YCuBDIWgkvvQSL
+Ux96hv2HrPnVQxFANi+/x9lSBoyvcbMMU2AIZGUfSLyXmMkAJJcYMbSZk2Sr9G8FWrAJmGT0/KAJ
+Ue0TlMR+3yW3ITp+gsLYEgW/yn9f4buX+dYFfuzxUCKXSPnDf3vqz/0tfP4s/32Vit3X4jpSJb/G
+Dz8b1znOZuimr7IZp+Prp7o1mCoGq41LgKNJF/72lRPULz/Nz5zn76f4+0/59QsUR7/KF09Fky6G
+bLnu/ljc4kKohl+Jt6wlz/OVi7ydmvRc3Esi5Wepp37cm4pffsineJp3VI9dDIXy5ylw/mTolL/K
+/52SzjqvoE64EnrnJ/nd7/Om5+Iu3w+5d/XkhUyvXQP3ZIjEc+C8n62jHnaZc7z1ovc2HvDbFGJ/
+LjrhZNzr+yHcfoq9kdTWX4yOOsEPqz3fjZuejx5Ty9+LQbc/nwqV+h/Gvb4bXaQLShL+fb6o3r7I
+vtJ8OBsDrcfRXH0vevU4O+Esm3eardXXH4+GPYbXXVde81lzVaPzJFt7LmTsL8RTvOBTxfv5vD8X
+3j3Df1+IB3wl7nWc7b8WqvZq5I/iT334YT6yVtApvphmy6lo3qtss34/4w1Gm5/mI1yJ9rwaU+v1
+uKma+joHlzfyNh93/g4IfEE+hsUukV3fOXMwcCzFA+V2qZLFBGChMpvYLJa2ApvDxBol12ADSbth
+qYx3xSiJLcx1ab6wZy+ufFhXDhBQA7aI7upwvLkCa9I2ZLOugfR5CLtTT44qrnIQki5QyCydb6Mm
+EtpDP/cppFXzCOjLVvZotv3WT+8qPlpmT760GXUWnSIlsUMxUd8oBSpxlQkzE8cgmi9u++qB3cNU
+LZ9QSA2CDopksy10lL9WkoN4NGiDb/NFMhJrVhDgGwgqrAyR0VB+lzya8rbrxLy7v/P5wNyT580N
+OTcV8LzV0UayyM4Zgr5C4rYWtkbqVPXWkTSC90PMunQE2FbJiPW4F2M5T8f4y2uzHbjKPWJJPH3N
+fvYkbCMFPviFX0lZxa8nTBjT/+YyDbr9gZfELjGCzaugOE4uKUmhtujNgnbT7rK/s5QFOOlcUHqy
+buZpentKzK5ycNB3n47uFfzfEQZtNy38lBiHpsVY5ZyRMtET2TljTQVjhqquZ+McniNvZumrZxgK
+4gCO4O3b0wpwhUmkcPocgLqpZinUAvrqlIHqBMtKp3AUg53fyaRfSGiVmdAeZ8vMzgO2fevYorua
+W+YyosJjYxKJ3dXIu4vODmAa1sh7QrC7laAfIAoT02uEEyvGCVZgbEw2JlsDisQ288pGz+5aJEsX
+Q9hEjbh6MDg68bx3sYfYp4FB65DQcstZYJwfA/UGY9XmRXQXfYpsyUi5UJEpdutNzyfaPDgoIFXU
+MCLdAyeOJtLq6nhLwzqAw7bGci7USFLafjhwlJnXg9diSECRA7hFYpdad2BOvdpJlUxUkaOsVcqx
+L3SrGP3VNNe2xmBGwVWqHrkfVEXs40E1WwEGtsKP50bCOcmqVLYguYrdjb2x8sAoLlSJww9WxdbJ
+adAgbrvCXPAX0z45M3MHvdRilPKE8Dhq+X9Ix7A+aeSxnBHqdjd9DTISwedYShkBhZUQM7oPAj5U
+RFupJgONeXAlVV2vCrS/HEkkxhJfC2IEqPqdhA7YORO8KIuFY3RY00nZYlSdktsVRPoJ/BRxLXAt
+MYPPljoWbedMkSAfgccoCkXQ+GwrzrtLVjqFi0j6yf4LzoKqfz+r58coa0LGlIl6YCXMj0E96Gaw
+RxEXwgxo2WNgB5Wcx4oGxbjF5xkOYTzDWRvAftkvHFbkqMSHMBMBeERgAtXHFLZsIsoGRgdk3fB4
+jHkSULrxhZgbtls4TnU2BbXJKop5hBOM8vHgIeo4d3rX08zDCZFuW8IIxU691q7fY4mbQ3CItXEv
+cFTDBvVoYBobbxJewtwq2qf4RH9gh6IdsqNF5/9AYFeMXzUL44QbchVwTI9xP6ITAehbnaTQHEjE
+kUzBINn+TDXlIdavDBOf42Tn6K0qKE1uRq7zns1OT2RjLEl3vydhiwPwZHtOrzdW9nANjMcM73L2
+ceu/X7uZfXlv+u5Wg3g0Tk4is5hDUbkonshmNl5A5nlALQDbwEDjWnHNJMBxE3mG3mQ5gQgHZEsG
+szGCb0P2br1JzCzEvdcKCRHMlRKPsHvckyyFr/YDh4k4qJKNMwn6XCv4aO8eHERilGAASj3cL+FT
+Yu5IC4QsZ6ARisMRhZ8TywPrYQXLsavsTDeB3SMA50hUrNY+zC+gU2wfUvbegWS6svh3wcfzP6Vs
+eECXV0E56e6ugr6waWJ1Ly0ku+S22oypPYtFXSwFHHeVyCaBXxL4t1E0GOst2I56k9FAPL1EZaMG
+bIRw824n//3WTOxYXn8OW0UV1Q+RQ6jSfgAQOx9t2dcM8RwDD0neW5V9Vt3OxVqohEFgDToCzhLO
+ECYDocQjyYoElFE415WyebAilxsBj6T19lgo45KFx2CXUipTkAPMNWrE0b5CoGGjEsORp7wbRW+x
+uTrrnu0RSmw1JAWlBDDYYVMuKZ3xzZe08/PEdvxaWB5kN8JGQ8wPepEkNDvaOYmAEvt0OTkgCfPf
+MGzLJElkeKrmjrSHJTB9ozzlmLoxKh9FnxSeXSAVI6mmE5Z4BwMKRMG4eYq9swqd0hbJ5FzfHB1W
+KWJ5J1i30vjI5CS0c+FoGOvoe8bHzHazzlkPppESUZxCE0uZJWTcOjKGO7sWHUhxtBQajUruLKEw
+82t/R7CJOxE1doYem1++c/YmDkGD1zHY8lN3nPaDVFnR/3J2usQeO+MwVSSEaSeS65fIXpvkMGm0
+jhwybxu55KrR+QfSDFsD6IJ750xKjjoihCF/1IMSlPi7sYvqpGuIKuq5hyaYE/03prSxj8/GfJlN
+qOBGQhDYFVIeplkiJy1MaB1ByJOQPYVYbnk2pHJkFgTvS6mJp5/fAqcVgWW0brYddO76f9i9SX+M
++3pJykOw9dacZIDky7QUsbPa1W135/xD4LLaCpBQQTYZksECsq4rJ5zrF4J5YrDWHHXA1bx7T9b9
+fSYNYG8cHSKb2y9WJglM0yRY6F7sO91hebTsrfUG5WgfyHxrSNoQ95Lw5y2sv5GSNk6kO5J1vQIN
+MVqqW8lHhC0gkAHtqz4Lyp1FEjBLL4goBBrDKsMOJ1AfPNISOZTSedV6mGtemNKQjdKmPUHDOu27
+9ydfvFfHzDkszwHsXXrBzA7s8jSYmoRNpH6fRBjuQRWzIgRIxgsqICFGpsuJkBdEQ3it7oG0alOG
+d/MegTkHw0mCu34hFa1AfdjtHHiaFUdQfQ9ZvrDLcTdwbKAnCUtcMVcRiJqelIYOJ/vemfysY0Bt
+5qeus2N0kPAtlCcAAbMnpYVmqgShJXxoznXSOBMd3DGzRbVKoDlkwyHznPLhNteCQy4ZlkVbXFUW
+CKYPWaThcPAO4EIQwNIK6NPytR3YGTlS9rIQ0h64kFTAUEgplceBWC3Mgie6qSzWqqPgzxfGSfMH
+/ScUMtAsCRmxfACq9JRmK9KU0B5BQnjnxKzIcFyTryNFIWYU80eeXuh/3ONOKiYX1fp6An6D2QDp
+WNtB7k8xmYPJCgoIcWc5XXnnQgspkBKTzYjdDzRN98Hbdu1adNUV+gYtuy3WJ3x2fwX3CGBKkzDI
+RUmIPvh0ErbSLHPE7zCn7k+xIDtPXKTz/hQrEJMgYwoVJbytBS6xQLDKUeokblQJWJzw7ARjg/Rn
+z549IEUbFOuoskoepGMGYI+TbMVnuwBW3caeYDcAiWuD/oPVhHvIYuiu7ru7CvtUhSKUAI5ilEKa
+sPSDhqsuah6W1naVQB1FwmwVzk8Jy0hMj4ifSBUUHikzq9gkgO4TaCkYdnbsYN8jKhL2lU1U578D
++pg2EPI6EJgQDV53XLnSnSSbMaqwyMTlZ7YUKJ4cJiY5LpeXs9eORJHSHNJZsp+/4mydZV+IARR/
+3JmsggdtKOXnpXUZlX+MjFX6xhCe6zrw/E1bkQlfqDa/dUhmyq0aBTTiQKViWL/rqL4Sbe7Scgvs
+sK8AXHw1+RCo3UEcyaxN9+hhjWyRi1I+LD2fMqz/7UrCzuSkcY7d3kSeEkI9npOz9rEnV9EC+qYc
+I1qRZndWI1s9Y60zHos6mJP7OZvKqQ5mfmjhKAfOMG5VEGkY0W2z0XLKpoBdYAr68v39BHudcV1i
+G1WcjYosBjdQUXaKKDO4p66sL8DYM5OQdwKME42gYSYUowvxXAEhKz0ld3nJiQ3Xx5VjSWwJ0IIP
+oMhtjXPu0tMcbsr/VSXFeAuMTEDgrqsM9wGh1BIKnTzY8lPmE4rsdiEiOPrWKxQWT1WVFCRlXWbV
+tOdvVKTNr0rEGdDNfiFdMBn0u4/6nJQ9cJggfjy520M1TBGfnfuoGwoBh4GroWC93lcdLf5XwOLK
+MEuaOlV9dtO5Oo660cDm2NYBICIP2DIi4NsV0X8g8VYdLlnfDgoAZY8fVa2wRcru5DDhJ44tpoxS
+rI8mcCSEG6qkAyaLTqtkKdDuHMFHRGWDcFxzRbAxqdYC536CymIfcdJJ5/DG+AIbwuN9IUUh1quI
+tzNBIx+s6snSqVIBcIEKMu1woHNMZGVmA+83g4zAVQ4XFONqStqB+0UgcdgHAB2Ab5FrFSseSOGk
+lSd/RnvOeAh/PhhDB67eIkSldoujUW1SpKLqucjUcKceaz8gvI7Yb9xDsl+pQrtJILXiDu27QBgL
+VLh3zx5Cp3lzlzALTAwZil0xGxvyoPBbp6oGQm/ugs214ZhhaBU6k3pnGapbMmEiilP23I/qjjdd
+SA6LvoidDXB3rf4iwZtTje08EhK7WfdCa65X2qHl+CJ77G4qke9JSq8oR2KHxmVKylcRhIVNeCCx
+ARU7Ch8vFLMfgsVA4EyeTAmZmjzw7UrYOdiYDXZb0sFrlCGy6VLIBWwqqKZgDoCLUyo88GeGtViH
+B1uOghQSWWufE7XsC0iNc4a5GAYAk3Uj4URK2CkuRVbiCfGxTAzBoMA6oPg4ZUpVMIV3V4YBXfc4
+K1wM1n9wF131OByFexpdxeekGF8FNsNsh7oN2PAx07rgDpDQs9b5qnCze4q91Sb+v3h7iskspAAH
+ThI4S3QgPSOxkFgFIDYju3CUYgW2c45saxmubyQbfWfwxs6uiV8Tgo6uN8sIH22VnTOFeQmlok0r
+QuLDCAFHupcESd2AMUZiJAuHeWGMDsbePtcWnKAEG60j8zdNnXGTKAgKx63aL8vpVEv16UseNIP0
+HeIohK7NS/qvs7AApnPPkZLoDjDHYHu3sxY+2GrpVY9C0HeLABr2U/ZB/LZgPvybH//NH//N6b/5
+s78589HDZHm79H8+DQKlIdxK+PuVFDa50xCe08BTc0QTWc1suvLs4bvjldo/J9urdjY0ImlaZFCT
+dtZK2hd4LaG6UMVJ9xdz34FZyDc6SxmjW7xHQHjrAVVXeLeoNasH5DtQWwYrDr5KZddVAm81jEDi
+C43y8dihw35Jmh1k01LGmFlzsw1wHol5X0+pWFANLa+ePY+9ugKeQrjepdBfgltJe8PaHHi0Jnjo
+UPbjoKXGK/+qh8ogVaiiXwC/subfuATRhUQuAb0Xa6bZOg8pFlkz7e6wMc82UzwlbbxrSFeIgQ01
+EuJYC1qFiiKpdFnIV3SFTERviF3qwxP+Gn67CA6mD9758FmyKF3nq4+TEwm8U2/Yz7ft/csfvEc+
+psRLRVak6/wJHqv3yTP1dmKTutTyUYnJiXd5K7iTPjyR3gUH1lVyK10la9MVcmCxpfjcBy86d9V1
++8ybzpTkDFHitvrwWXsHfFiP6CkTj9Q1Z5zCb2jfcfbBO/xXnF7inrqWGLJOJg6oC94b4Mt6R4xf
++ARbiG95m60twfKEuz1iPQeGqff9ed+0J7/A716N35zfSXxZ+B5Yqdr7ovXvsdfVZjGKkUGMd36c
+ffE2/+Z37bVH8AqZqDQib5P7SxxSb6bnuOyjFqP1OPmvXoxx889dza58LZ6anFt8F2xe4qey+71E
+jqrrzjgGLqx3Pvhhusd1juglb+976FNntMJ4vOujH6xkV8U/hrG0PsW4sQ9S/73H+14mf9gPyZ+F
+PjkV9wWfGdriIyVOsvdj5lgLwRL2rF1NY/nsh485e9gJ++YJXuNNMqxdILPYyXS9Cx/8ReoNtoVP
+EjPigj05eM2e5hhdwLV8lI/zaeNzmmfX+buzetmYv6NZK3Yz9vtV/fbhd3xuvMN5dIlzFxxkbB/Z
+yp5JrGUY23fxNGnev8N2a+5+19p00q56ESxaN8/cfP2jJ2++gpPCWcH0Uxxi18AKJXYp8X6Rhevk
+zbNk1nrqoyfAtGWfEx9WsIzFd6/Yq6+Qr+phvfbRiZuX/BvXnI/rHJjLyCD1KE+sM/jN/j1hrQJb
+1+vkD8M33iT3FJi1yGZl775l93/KfnOWMLuyPQeuYvd5C6xa5Cgjl9nN69aCM/iWfeM0XwOvlZ4V
+nGHnU2885e17wz934uZl+689A9txMnGqkf+Mn/9p8LHFs/FpvN/ILibWskfEa4YrgUmNz4Yeep3P
+8xNyfr2BtqhPwYhmr4EhzPvAnvcEubces++eZGvE0IXPXWZPnrQeegNPxH4gC5y9z6cRkxgZ206D
+2yyNAj53iVxiJ8FkBtYx9j3u+KT4zMCO5p/D3c6qJ3ykraV+N7bGmc28752T7rU0xi9jZoA1LljO
+/CrnOZacfzdf9ec9oVEko9rr1vaT9vyv3XwLPYyZaK15lOP2lr2uGaY+10w+S6azNzHu6nv71OvO
+lPcWZkxz++RIuQ5WpFlJjTBDuLcFLcyv12v+qs5lAt9C5VpYuJ473Z02C5YqxmD8i+TLTDfhp3ix
+qr9dDwdEp3iOjbAYhGS3AMiqiCva7JbNxmKqKkdZhKcalxaUBqcniZiZp7s9+R+otWrEIDMj+xsU
+yW6z0fsSTVdneU8L8KlEQgDPwQE+AuKgdtWjuyLncTkc97sPeESTsKaAhpBHeMu9yGrkesmz42Fv
+1uEXm3gKpkMaYXp2g2qZTghTMihWo8lv193VRrLaPHsz/6X7v/hNcmx9M1lJo0rJuyWQabeZnsWl
+NluyYZaSdRfcrpmZu6NsUnoxgkoi3LQPSeXF1UFCMkp/uVDm3VxUmPX7OyLVYNMRlvnfqhJWeSIq
+qbpbG4ORkxwFgwzCDr3RYjPeArRRiR/gcib3sTqKZn3wMln3bZbHVL+3v9NYIx7SJ+5ohzuxTPXA
+3u+kMsXSQkg2LY82GOFbqYfdYZXYroRf4zzDSEWe0SNYddUAMNJ4xi/FWpuZHcnTaZEi3ah4o1KU
+mMgQGnaeujp4egEn6P4v7ZRrY+CLDPayKhkFzyFfwj5TnDS8/jVcUWFD878PrK0zHzFSaNiduXtt
+ckEZeTCcbcypgwdPVExfHttKJZksJ8QgoUJJwWpp19qNl1poESMrKnmsKRnKEFrd36ajaQ3eNb+u
+KT67i2Y4Pa3eHRUUyRhX6LTeYa0gMzv1WBn5Vqkr+asIfGiRtcEFhSPo5IYiCMt3A5bTrKRYZQFl
+eV9kd7RrKHirbGXNtwAgAU2F0Ew4wMZTkEx5OyqMHdWfFIoDdVOcqZDwkmKPA8IDkHK5lzIRBfJG
+Sy3Atd137DqUycYlokKGG0gRZG4o5+gLjchIkgJV4cxBMc7jaqjEQ1JevnTiG+xNqC1oE60/muxu
+k30gX/eBlUwrZwd63zaWHmpjB4AT9DETCaasGRsQGpEgp6/YZKkR17Btu0g4J4eEYXpKS8IT+IQQ
++Bh3KxVvEpLSDFf3dxaWWnijpziCpMVnqnuqyKwvLaQEX8gnYn+oyUalumUWB5IODgfVqucOPF9Q
+FRKpZjBwXgfafagFXh/W0r+rDrT7TlKwBEyZsW9WPyqqx0BbqpstZlsINvrXk+5OsrN3z57fRgzF
+OS+WWsgDyzwxyvs7u+YTkVBa84P+l/uRoSpCsA+5CWclQgB6KPyOyg2zxIIiZ+R66pXDW8AUzaH2
+GA9YOuevPHbKchbc2YnySRnvduPa20b/C0nl8Aq7Fm2RarInQhBoHIL5DdLufQeQImIEpHNf22dC
+hXeWW5w+g68u34QvOL7ht1K8ZXHr2GLhZ2fI4CHS6LkUxnM3nR+i2+YFCgfQKZvfDDxkvbTxP8ew
+dElXsao+cxqBlUlbfdDWg2ILKiL5f1QEg4rHbQGShghbufrfxrUfrIqHMbhLxaKxUIvCn9iv36C0
+BrBk++IAKIBGQiQaiJiqrdXoclMAogeoli62NfLeJCB+1JxxAiXk+nzixOtNDmWb+agIzkcvnJsw
+dsqKfEANXZaMTw+sY0kQusTsHNGtUAyho8PInWG92V84QTBYntzltqlYI0LjzmhTknKHtk+IeSAW
+mlCRBYReFJxuQi1UDBneJdKEAzUf2ThS9qwS4Jl8nWWKe0aC3gwim9Jg9BtAHUpqowIIrbm2EKPY
+Gm7W4Qpdoc2fqhXW1MDrNAPelE1XygAlx6HSCcYconJZQh+aP4TsUCyd/SAGUnT1ocNfDp0kkt8M
+hgDJtemsQuwiZBNxwS6SlNpHg0CNFcuclvsS1eEsJhy7xGxlR43HTiAKoYUHmhDasItFQTTZwiph
+ltq4MKgd3Rhpj7oZj01jcu2cCRGaJYcFH9G5uVVr3wFsyxNzIZsJ+phAKFAszhk8aBD1BT8UWaFy
+oERzCXQ1KJpywkG4szXKyiIBkYIjFUddgoc2zsVBXp9ksjahGmdXuFtCX1hNkY1nakyUoHVvIkYU
+wV2Dbqc3CV0wpEnhD5TEVa75/o756/A9+9qGSh0ENgtIWBPKrbbeQrzHBpbLX0ZZs4GloxL3UlSl
+IAFikowvh6YYljRBisxKdVqYMTEF2ECbfaTQ09dsrov6b6Nq0duF0+Mi/zDgic98LzkOoulUfBUb
+rkCryO46pZo1b0PWJDg27Cg/5IJ0nbDvrFX9lONtwZKcMF5sMTOT2ZOJMrU9e5ZUu3DnNiFrkuqy
+u3YKJNArO76+DpnHWk9BWTaH80n0CYuGmdea7xxsT6eojbGnUKKK0/PecTIicUghVWF/toflF7Cj
++wfcesVfm/WxIPJYqUZHK5FnhNAbpkYimu4ll3YbRkyAQCNBQLStn1nb1cIdR+4o/gtYAZyslZAe
+Lpv+6kSoO3IF2tccIob1223t9TahX4QTZA2dT2wDBYknZEocaI0n7FGu/xMQK54iZKUGloz7pvgp
+5lrXJ0pdrJ33T0UEvDivCTbQ2skydONyZQVng9LS6xERaFKOqGi98SLUUMNOFT6T+XmZiwH25vEV
+Z/DeEPG2r93dbrrIVrnsqjZ6ThTIBJO3aOiWAjcxDoCgai3GPAM6N1qQ5OWAmUSo20rV1ufZXb94
+7xFpwB1orW0Y0G6t3JlCBnP0AGlQJt1f3AvTcIUZYc0SFI5tkKxlg7ZYVGO581oJtXgvzVsBIgE7
+8Y5KqJm+iP1oDrfISsINPUNvq5FYCzuCDrX2ZKAJScMDfcQudjlsYZ7wS373dlbL1xRtXSn3Pp2u
+mlHMqSbKG9r3JLqo8vojMsoU8tH4QA4h2lAWnIRuSO3WlNXaSGiKlUka4yg4wwo3x360G0SoSULO
+jAu3dLEIlL6uURM4aK14nHMACZRdhUPslmIjJnkAak6ELsCUaw3OuaWFdLJW8s/pVqcd5ncPtf5F
+WyHa0AfYzTzdfJEiIyHRRSrXZLWplInHV2hqg0EKgGQZcFmlo+0/UYfDW2jpuRQmxjsRo6OU2En2
+rBFeDwf5XTDcCUsfJM8ypm31EgdA0bYonYRhE6gnRTiKkhhD+9/WVp2Y+XvQVA0F5xXWvLJlJBTi
+zDiqegJ2wGZl5qdUi49ETYg5BAk63xxpXaMA0ZGhxytSkRJOs6St72yi+Ag57zJ5i3YiYmKRyEca
+ypBMbSGhv5+wcXU/8e5XXcdBwlHRT86dVPVHGBu5Ley69zKHTBsl6IQzLBiM9MY5GG3StuWMwTrI
+crhUdBrldQ/B5pJ2NvZ158UknrFbONKIoDWtE9DcNYKGyDnhuN1PhgrqbpM7yusyR8nu45LGMgAi
+u6CJBt5BFumxdWSBFPFmEKWazXUoVQTNtRDEZkQ6IzpuNlPDCElxubKXgpplL+WIzY0id5JNZ04j
+lKI/qDlJh81GMqlNFGVxbLPXb/YN1veb3ZLK3FMt/LHRwVRr6CTi7DagFEHTCMAny6kISm5R3DMh
+woE91ZrjPgq7kxS0ZaA6yH+7Wfk2qKgaT5y2SA/EXLBgsNMzhqf+pdnc8ODCgeMwpDZUsdiW8s/J
+USeGx9rwYFVtaYyj0rnBBlhQA73RTIYmSOegO/E2XqGTaaPJgCtBmIuOwcWSEvKMUTEPp0Eu0xoE
+8kBMjVCUpSnBicgaa+8HsvoDPkKO21Ah/28d6hyoyG5QFkJrEGgkdq5iAegtQR56kzvbnMTOtvzF
+h9Cmw9xya3DuaWsqcFunQXToPsYoKhnc0XX6nNAfnwI6AkELJnkHEHopR7OvbyfGIikCl+iAFqAx
+J62rQKKy+7C2BvAEMLPMQxOMiwYFD3fBxrxkHBMmRBPQJYRqDqg4n+rSikMR3x+xxtQLVBN3LaoM
+tFXYzq1nYyy9XtPZimC7bQprqigqbeKPFLDaObNDbvfOGfSvn2RrAtXnarnYXrkuaPB5jQ7X/KBI
+trdCEeyzo/J+cUgMExCSPo0SE0Nq43pupgiuQHIzNvKDyGJIlsC2IqNYbc+soM3DQgn3eVcWpylI
+0DjoOwrLvd8laiuogqNl0NgX0FDwEATzm53BUDqsV4b1eJMV8jrqamdItSUNVlHfU9sCWMYfgJuD
+PvewCvQpDUg9a0hiYxMDEaBvr3DiFXEJxoy65T2lvsl68ofMWaaqMiatYlh2bR/13d1yklj9UTip
+AxLou7F04MHl0abM5JNpHTfeGCDCgSEXXDtx47tWBNnduQVJ/UNeAMaPwFvH2g/692IFfBknEWBk
+qsAMXVfrPvBEIW9ifoEmLRWOw7PUCRknmU5exgfKIvFmo3+9DYlntB55cQH5z8mbR1MIU92nQaAf
+GcYi9Ua7eNmypLijw8dRiwHots/e33rN0uzw1ZKw7kfBFrrO6viVibrGYfsOP3VmLkcRb6EQyR4+
+6v1dvWOk4hveimTgvTaqNs+lp8JBp5yGMRK+WKFiFfimhPlx/Xx1/nBwJEyhMUEO3ItM21pLCIDv
++DmeZDgAty82QGBKRClV27vk3vSoDDONOgQHa8K0uqHlsZXRYK91wZ22qQKtKRcVxmliP0NW2WtA
+C+1gmndkT1V6xy8Pny8UhEkSWwXpuxuF3I263bvEMlbFbr9Rb3FdeizTA4LKpIbdK9wxKQyV+rbf
+7ISkfjZnCbuUW1SRMLsb2mlVfBgbE0azFxGgxEWDSetugp1d6FT4rxCzSIVxpUd/OEIOB1XsVRHk
+uh9c1aPuct8tp1rU0yQvBo1iYvGhB8jsS8o0rYz6ECX3jgoENTaJDRlUTIMpPcyIt6w3bNCg5W1n
+iYfI7rFd0F9NZZQ4hBssS5DS3tmGTkKJHIuhDfW0DDNzrn0w9IXjFhYJNJUqSrF45bN45NR94DAf
+1JYFC8+Fi5PMzm3QNy0QPGb1whrOYBRtuPA1xqJ18MD90/d+kK0iF63Fx7ecQWQP9RIORA19ci3H
+Cbtjh9ddqXyriFoyD4SRzNW8HfHx2d0eiN0osWX2Jy2Xw1wIsYyhO7MVEQEUN7lmCBhmfQjcrGum
+qiBAbPOghxRRr48QfK9e1qIqoDVOY4T6a13J434NBpYjslk83UYEUhgXJ47oVMkc3MQOjjXh4wY7
+PKacjlqOvGwdzl2PV2GyKmwiKmABMKRHsUoDeiiZMFWkLbduEq1//doyVs2Fsk2BSVuG9NGodTKZ
+4EjFpmGfssalOojtP4VsbViq5EMebi3+lt9pzqs0hIsOrqfGSdjp5ZOAn/uZCvD5Dk13L15R8Rkm
+aFR9oM9cBMrMgwQTwenv2hhgcMD85orlIjvG+FZ6+JpGjtslWE5urYiehuuNldOiKXAjH6TVbZ8u
+sFCDNJET91Bxrok7l1kv4SAS5a2n1eGH+xM70T0sYvFMuGkZZGbzXLFkQOi7HIqbX5GLoEEi41RL
+EqzAcHT73n38AKHJvUQ9i0ouxc9slsMw9hHyp4Dps3lrKjntcl9Tc460ES2pM9B4Otz6/sFx84Xi
+m4xHFQzAhAnEZMhWBChEM8NtMOpC6BqlgotQBZJ9BsmRnmLFcF9IQqHqnz4p8LlgzIAkJ7s/SX9Q
+RGLOGVkaJTgURLVThLsllO0rlj1sonZ/wc06OO3uMMGBVTikkaJJcJrM0ZCVS8PPKkjBadSX+lu0
+F+ojabdX7RSnPYBRsfSKxN+92YbwfQeH79/SzM2Rnh55NjO30rY97zwQTR5xqfs7MojQqK3acbJu
+LIYmOUwp4YXYTUoduhKfqNO3I2fpq5BdonMFv+7IiMIgq8Ai3a7bGhILonVBfQvuk4jJNlgtHp1m
+ZaB2JNiIA+0cHrFEWWN7WM4f9AoNc4/8FlLFKXtOD8LwjSIuuJtt1Qj4lK28lkLzUoorx326BNxW
+tn3fcWAN1mSqTJJn6TZXypYcHTjjBhMn8lZh4bWre4496jQafRp7Ls/ge/W8F/JiTiSY4+ok0crb
+9jYoQNejNBjTvcoPpaAZ10XPl1PiIVEeHXGWAejJD4mcJnJqG+CkLWKXZ3y5Aqddo32HyWKnHeS+
+vp7OzSNt8pyNVJzUi3IpT5pM9zk3x78mIaSJl0M3PZuq/b96BkT0qSqmCaK4bqnuU4lHS9rb8kQi
+5bLKANGNc6Xmr5fJ9KOapSTsQoLp6gdoh7f1J00U3KCOkCIKPBYBBik3axKSVXZ1oUNCQYp3c9M9
+SBl5i7YCJxXjWCPhsSKe3S27blriE6tgztUtEnGv7TZjl5dv4I6Cp5f0vak2phHdz6rXnIy7dalC
+HsIF4BqK+5EfsFXeNVvogRLc61FX1Aw8V8r+9WQqaFKYal6zZ0OV21DNQeTYrCE8cfBHsifhZ3EA
+6N1qhAS4BScPS5Ecc6PIFn/t2xawnsaCpDZ2E5CLBf03oxmifdjXKfYlhrAM4dsgXBz6WCG1gB1l
+YSERNc18WZqwwhIF3tF+B2+dpyr2JeFOc426FdjVdzOcxh0zuMwygGn0oJRzW3zBQhZudOJMFCDO
+Fhk+KGv/QgvAWq0WFo4ePTq/Phis91xh56GQFR6EtZxlsRV7HnpdJB0+RRmV2azU1cIhAWy7b+/v
+mX0pccUJiPOiQRnQr81eEufYZvNc8UdYVJBTeNv2/ufsmqFRYidilv/ufmFP+5ndGaaKxzOyXis2
+XsstCAZSO2veQbcl+o7bdt01ZjINnsIccRHbHpJK+d/9iKIMhn4CdUH07T6Y0BWhl6q6YQ63HVhe
+Xd9Q1qqA6Alq79uA9f1Vv99MetvWAeWB1iyeW8IoOycfkMMLDh0GS0rQ/AMtYkunZz/LFiDTm2SI
+tBmHHwlondCeZc/ciANB9pXhmJoUCK/FiRVJvP1ZJNAlraVTPN8ddaGkYw1uGSTL3sFBO/C3zWUw
+nFAjBWotpdFh1rWNyECRTRZ13FsWLavMUluy2rnTDq9Vyl3XpdAdAlpmaLuu/C2B9BM/qD3jHfYZ
+b3ST9dtSElOzMU0y3zh0YIiuKdrScoYhqtIaLXEqz3718C740f0u/ZtZBxspnzhfZA5GoAVWq06G
+MhKWnWmCxYOMHR6lpwGQbET3knVOl8Z9E4itZd5SlfXPYpbqf2BsZnBQIo7cELP5kad3DoE3QIbF
+XIbO/x3nlXT8OHnUFeRpiiSlPey03LwJhO5IeJQCYD77q6qWT3W81p/YHzzp0LIXAyu5CT3skDvf
+3PJ9lUUT+zv2VTM+o8qWdr+jnRiRkODRcFwtHma19sLCnfdZj2NcXGxFPFJKqiFMHHtFhh4tEsub
+zZ+DWRsSdwm47X7rjyAtt/Zfd+9e/lYb/59JrgeV3BNocM4lpeQpey250s5MzXJwZFsqKjgb9AlK
+s8KHJpB23G+ZQSn1GqImwblofZKdI0WSMi+Yne3HuGdO/kJgJcR2i6Cs84mJnkqIQ5wXrrazlGWZ
+vzimhSu3dJuA0R5Cux7AjdSDXElbbnMz833kQ+mbtIB9cgU6unQmx3YqtGSbw9axIktR7E3q5Pa8
+Wb3L3k0A4NKYtucjYTPui68zHKi4s3kBOAskrRt1Ptb+LyUm707RxzKmg9I0Lcxwtbo9VNTt88tZ
+NDE7pmayYSwSC1iSaNHugs0s0P3ZWjBHp7cW+3NmDBdxK0Jxgy8uy31MlNiJogXzLUdwgXqEvcJR
+F0uv7UXHNnv7dAa1rBpgtUX6UpItiTyVjlSVBiwHzybRbXvCL9nx1rgDfn+2z6P+I8gtEMPazZvs
+G2ZHxy53hbi+4NLados5jKxmomiB3B9EyQkTaIrEgSN+HnrfC1nkoaCK8kbdtUmwaENq118hDld7
+nZiwkn43xgUBfHdI8f1Q9t2RVUe5mm0pJnIBkajUCXCDosQNhezrpDoqNR9bJ/cNoA7OqTSXVXo1
+Mztan9f28hTqqVroHKkYg+R31lzeghqGu+Y7RctXspXtM/h8PDLH3fPiO9p0zsxM5tgjkNYLDrkW
+KFnc7VmNu+D1tmdEk8Hsta+mrRiDcRuGeUFwzmCcLVIomdPUQx3faGFQ+7KAYpGFtouk3Cq0VW/g
+xSGhj1zl8Vl7/UDRglszaHCRoRW6Qm2p/ct5SYsydYyvrrQ5eSLLhe+pYtyZX40gM0M23W7VRjHa
+CrW2rKfsHYbEzKj4KsRS7MnD+uh6WJp371O2VbkQcvhEkrsNaxZZeWBzqE+tzDnmbNvblvVm6++A
+UoTTn/CZFBwCrfQg/IWtLBfFTa53+3gF38kOyCzIV8jj51LqDtuorqhrrPk9EqQUidbtUGYL0Yjz
+fS9zp9pJW0DIN43XcGNsf0n6mL5bnF/+eeK8GOwZagJlcd8iGM3ImMTrEKbSsJ+9zeWwSglA/B6A
+82aTcps6LwqVKwECTyFLR74CfRcwZRvQhC93+AFhEFwjXv1J4H8l9o/DpKrEzl/2W09m50xA8xzW
+ktSrccH7PBOUFu8Wtle4FtqHW8Y6iBiWIPwfN57mC7wUOBfjzGWu1p83MkYIFems0b9t3K9TiBdp
+i+uoPyhS3m1YRYlobxL7HubqcoaYZzLNc3L8YugwZjZY5DCURUtAQHbmIWybtQDE1GVQiA5vURvS
+01Z84WDmd2RJBa811GrGbbink4/ZjUOGPwUwsv33WNSo4rmy7arIzjLl6Zx9FnZG4bkjgXkUAiYH
+m5erpLy7zhrGTiUs6Pkrpi6Jq2mQkPV6H24TSas0wEKecpjN/LhDo5YsEBnROH+tWVzisMOVexLC
++EDmgzvSkNM4K9trMhumL24xtTNJCYM5ir6wpzJLVlTxMDFrI3EEHdVaXxn0uouJW06qmsj3Ei6U
+CpWwp7XldbW0JhTxoLRs8bVyWBw6NOIKIblqE+s4Q+9zWy6dZY85/BahzvkcoUuuu4aR3yLWIPq6
+taVXJsIUKfp9MMVSJMwKa6IbvomfBVn+vQBDdOEOzf1twDnb9wZ9z5QTIJPBN1kzE3uLvGXhXgKY
+6Kg6oja7WQmjzi9XmVfYdytqKfxsZd+4vU20VlbLHrWo3DSZmfIdIgM/OEu1KsKSOCbWkc2+2BuX
+s8MpVTx5faWXyDZZOX3TyWI7Oh+JF7B9tfVZQgeCMQfm0ApO2AE2eTeinQ2uMxpsdRIrOJGvCQmd
+I5J3Bo8rkg1utxCuoOw18waNK4iMJEKdKnsaaRpGP7vwCPIB6k8vjmpr+rgfeikgfTSPe6hUWH4W
+GhBAylQvZFfTXuF1ezjrq+DBk9Zu2LFEEJdDimnG3sEz1BdBkv1lRjAVazbMIfvaNH8KJGZfXq1a
+PxJ5gOBfw1wKPwZrU0B2zRwmFI6GPxt1vo4x9utn5V6+T0oqg4dOgm+1QavM7Z7B50lBKq5ZqrRn
+iRduSQ204uDs7Okst5zzYpKT3jwLAKRIS//XHsBpDhyYFcmnJhD4wZXdx64erOrIEK7K2sCiStlj
+lh1UKQmL9RZ5+zpjwQ+MnD/vKNYO7FUkgeAvZAD9GV1dMYRBiCXbLgSEpccH99MzSmgiVyllX6Uz
+dy4Y/biOGuDnJEvRZHGeKP+fMDdqh62nmUOeFuZIJ1kw1CchqVjOQzDxjFlC3WTnVGJ9wNbhaxYB
+aMKpaDJATKeX5rztbyxuRoyxtRIIC9/UQU7Z9ITIOUpNd62NwGgAXeR4Wxait/sw2FjHKbfqfcvF
+n4S8UUdDe/IrtEuj4jHnBZYekyszYA9GdLhS6WTK4gb8ySt9XJiWNgH6rWSe38vB/fpaX5DjWPc1
+rrZmrmojtId2MGUeddhl2/CaqkxkfGiP1d4e9Ns48rxSwiMobUwyszOHDM/4wR7I9AnLWUNtoLP8
+FVj0xZ2bgHZHPl57SxHZxblkFzEbXySKZPQPt5F6rZrNypyXswfO4IdFlSD+ZpOY6RP7eaq80t7o
+xdv9AfccLBrZRrHjVMnBsfE61ILc5lay+I906rWek640rEqBmrifbFIxwnOt2pdUebganklvAmIV
+FvQhhoZRcTj3dr2q3rSOmc0C2S17M/IUbudgw424Tcl8TeoWrll/sqhBI27Xa1Q4z4ea40dR+THY
+TFUiKGwI/9ermPwsS3vIPPXORqPg/IYd3gitkHzVuSj3wbg45Jr10GE7Mc4TCuOq0S3IksB9QzMC
+a0D7pHpSh/io53GAUeAswq5v2rIHdkHLdlFTTrq1/RK4VzL3biu29U5NRb8gJMjTWq77iS5CcfsE
+61IB1sjXu4OgrMvs6VQD61giB7lM4qyW8oKqt4/YItLepbM/qk19nxlHnLYkoMttb6KCa4Kn06HQ
+9oPZWU2V4G2gwkZceuDazZFrsNNdtjE4F9A/rd902JPYE1V4V4LkrlQZ98/ehf/jf29+55v2//+w
+sF7t+gY8gaNF9a1Ft0l4lqMgK/FkB6IEwt8bg1RMk8pbyABcpjk8hOq9SgibHVkBUKpOT/aP9qKM
+Rwks0Z7H+YNdVZbT+eAF8utdItPeSx8e/+B9shNeJUPd//j7e8EvBkauT8+9/Iuffu/jq+c+ff5x
+qUL779ceTr9/+sKTv/iz70J8+dmrH1+//umFP/70e+9/cv2FX/zg/V88/vQnb71p//v5qfc+fueH
+H199++NrT376p898/O4LP7/09s/fftE++fNzz3966bnPXnvU1x3T4NYTQ8EMQlsFIILVgcfrlCq3
+LZJxsC61cQcBGvB8HD8TTJhlFzWUuP6NlyTKHK8PGinTMboM5aGV8oGSIstCgZdK1k+gwazrAxlg
+n7inXhnSHqbqsJnaYmJw3s0xjNfASUShuHN5NnWk+hvyYIJhs33GMpAZuI4Eg9CM7kBx2ngu8K3o
+d9sX0++4WOTOKsjdbVYOV4h43SBHiYT0s8AhKYIPtMVKyI+WZO4DZyAY+n5EZsOHwfzX8kIG7+aH
+T4tNEux/zsXY8naCXfI4WR/Jyij2SbvCZfJvBlthsHGCkTHd68NnMYc5Oy/m13HeTnINio0y7s2/
+3/3gXXJVvsmZfwV/JeZCsBWma9qncMU3yFN5LbtXxo1IfsWr5BTVPYMT83K6/mWyIwa35VW+R2ZN
+67u/JOumWA4TT6Z9uu3DR8BzSc7It+158SxgNH0SrKHO2Hkp4wq95D18AQydYBf1PgEHZctuedme
+nv1j4xJ9A/bGi+hXe6K3nXnxAllMv23PftFecwbQDy7xKu/b976dcUhezp79AvtJzJ1vO4souSfJ
+Yvq2t+5N8bByxPSZq3ymN5xHFTMAT0YeT/JBii3zXbvDdbbgql/HxxHMlWSWtHvbE+Lvx9kCzM/U
+tg9+3M5b8WBybr1jP0/4rLmWfRfP8j75N98D8yVH8Qo4ZTkD+eT23ffJaXqB/aP2ipv1fc11G6+r
+iSkW777vPLTv8Dn8M1PP8l42Fld1P86599o5zDtrBlyN9UV+zhMfvJStnXfEEQsu0cSieonz6F08
+H5+2XS+X0dc2wlxfGQfo5ZYj1NryCD990VrxrHhUfU2J4Vbj+y7XAObtd2zmvst1c9x+e8f5d5/I
+28/1eMFX4DWO/wWyxJ7gdXDft+xe7RzGOLbrKPGhataxv7H//Llz2Vr/ZNrcJ6jCfCG0pE+6/rg0
+0CE5HdLSLiEtjWz7wDN867n45b1Qx7bPvNRKkLfXkZD0q9k1z4R8OWWm8foJvnIu1LRPZe05Ton2
+99rP49/Lbdsg6p3UunXfa1T3Ph3Xfy4+czb00JNENQXE/fN6ihdcwVzXdOn2F9t7tfeVhPeT/OU5
+fv71TBT7Km+n1uq53uK/j/G5ngtV7vdDmvwKGyYh+Gg2Xv8BO+fF6Wc83j6XtLNdczz10nMhgP5a
+SL2fzPTlpTuvhz3P+/5FfEv9/3L0SXpeG9l3eN/0jCfiMy/yQa5QNf6ZEOlOY/1CjODp6Nu45itP
+ZILmZ+LuaayPhyD46dAoPxHPfibG6LhrxHtfxTji9bO8/o942dTnaskFtvOtGPETfDF95tsh3X5x
+aj54v11j153yX3xdpDlwPJ5awu6vxr1e4JOegF68t/9qaMFfbR85zVu/Avsfn38h66vLsYKu8rKn
+oz9Pu8B922b11Q9CQv1i+1zeq8fZnsfj82wernyKbb7AnjzBXrrYjp2vo/dCSv6xbN+4HK+fbuew
++g1z7Gx85Vp8Xp+5Guv0Gpt3ip/UfNP8fzH65FQMypWYA2rzFW+wxr2dq6kPH4/1/gSvdiX65NVs
+fziePWO25/gTRZfivqk9F6hWn/YZ3hdXO5/NnzQ/j8csvcy3KGTv4/V8NsdOxpx5hTdNa01r4fnp
+/fM4v6W1dp7P/n5cR/Nfm/xz03v+tfjMq9mc0cS+mO0DP40+ORs7+Snfo/CV89Gw7/OJ0vUvxAp6
+p+0HvPVYvHUmRvx0jAJbom5p1/vzU3tFO7ix1nyeX/aHTfuD/6mx0Nx7mtfntPFd+kJ7xvnzpn4+
+yT+v8jNno5HP+jP6Z67GAF3MnvHx+Pd5XuEyX38rOysv+Hexb+i8eyWNO9miwYv9FBmS3ySf9COJ
+9fnSzbeCsfmjh8Eu/dFJvn6FjMgtV7OYlE/evEg278QDnhiWX8s4ll+3K7wOXnDwaQczOF6d4hB/
+VMzYuN9HJ/xe4QGTVZq813r9LNrkbNMXnHUcv5/DZ/2a5z46wSupPW+Q9zqu+Zq9dxKM1Hadq/55
+MHQ/xk+B3/rx4Cgni/TLiZv8jZs/5lOAa/x0dt8nyM/NtjnDuRipL5G1W4zf4OY+iednnwYz9hvk
+N39MfOPiriaP+CXyT+v1R8hifsb77TE+5WkxVt88g+fHdfjqFfKlP8XXwWJ+Bn2QvkuObufrPs1v
+vO7XF2+52oNrv+XPeN7mwVM3L/GKbyS+7p9m/14mg7b3ect1bjOj7ZPz4EP3cT/r7Notd/zj8Xoa
+U/B7X8Pci7GIZ7z5KlqjfrCrOuO49ddjZC4Pdu8YF8y8N3z+vG6/PcErY/5ft7/Ak36ZLYi5cZqM
+72e8bVfxOuOKiPzBbW/lRBH535/BzMQN7thTUmqsj4cevey375BuOsLKJB9GNd5gc3YXkyHia5kA
+xYPInkS0Vuvh6njTI47zOTY4x+Q3S7cgMdvcdkZmBZwHeBA8Z9vyKQWddSeJvAeXJivQtyGpO2//
+dIJIdKhUe9AnIhx2L/I7qxtj0J6o2MIzv/uZ/IvMDIPsEYbdGkLW2hMaGQbi82sBQmelwF0Z6rXY
+VBaq7Ff2KMS5J2BwCIw74U8WTEGepCMQWYehZ4kq7e8gspmYzg6Pt5AeLA5CkyuHFhetrmlvMncf
+A94Ok906ttg2vKX6q6vmznzuzI8G9yAre9C6bLZFIJFZoIXXOU2yB/3vykAkcx7JVtEkxiHRfTtG
+eQ0sHOAJb8hCAuXne1FfiChevyqRTRyWosUYZrk4Cd2l5GMhxT7vKsa2g0zNSWyJRXGawQ2hTtq6
+bdJ7ZBiglMNmKsL5YOeRzmDWOFJBh23u3gUWO8BNc7xJSyjDMkdciDWdgPkkZnhrTpDRcIU5uEGR
+9sTW61AKhw0nqmvhsClanYRlZ3OY9ZEMCoOsF9MXNjVU7NAk4uHERWevfBFpRbLyojwxT5oin7LN
+HDWqRlva/f2dxSIrXHhg3LRFJ15c4kRsY6YAlNZz6J5QHSh2bEECwhlrC8h6tPgGU0CRjpzJA9xF
+jhoOnoFK3BWBYbK+doUCidqXLdZKzALOR+1cVZ7SsOkyVNqBBOtDzkunzyQuwTEiGX/50oKkFWLr
+zKs0nO0nYJAs0lqx2fUgGZOGrs5apMp33Ccrve5NWllmWwvMvYgLZDgFFlrMkKMgLk20d72J0Gvf
+zKFs3m6OdsAClnK8xSAvbmj5/KXbTNoT+8z6voVju5sNe5ajNht3t/UoXKfiqGc25b4MQT7nKAjh
+STJ8UdXS2Tkq0yUWOe2zvEuLcUSyN0eMxFYdQrFDilqCfSjHuM21CU376IITYfO4SzR5IvQIbfOe
+E3aUq0IZtJTMgPeIF95z3v2MozIVUpCqBalOfAq8qhuDTcTVJQOuAv5SIN40o7Al5ejPRttZwtWI
+a4dAwVQhSRR81WqeNzlU7/O9IP5RQlIMnTrpigwj3ClaPovlqURqAg45/1AMXtVVisxhSIfsbNkd
+gJLtca/vwyttcCIWgxw64deJ+Q6wWFIDPQiwhCak4APB6Sl8ip18Se633WHrsu8EKxJ1d4iT/sgf
+rmiLwWxbns/LTMTBHrwigdPC0Gd1LztnDgUhBuHphDwWnC9VjgOdd2FjSQD7+qmjE50hE7PT8d7M
+SieiAOxRgSQVhC+xqOLDmeRFQiI3jrzSyvIyyI1BbLcZnrQ3wR6/tRWswk4YztOF+1vC5JtpRUwq
+PrY0hT524Leq4JUGVYb1CJLFQaIiWmvKtyIL6RLOvKQWr4gEQueUkyIVVRERgoqIGS+JSJAfbN8t
+O6ZIrZzzsqZanCv5Jor9IM2wbSLJM7elZPYxcWQ7VTZSsymPrdqgKEyIYplN8k/jdAwIZ1si0Cli
+mYnbLAFrbCIcyHf/FrRnLdiRF85mLH+9SV5J2sQ5yT2EeGSvc+0Wmd5O0erIOOdlIvUp29o3UuoQ
+TsMeTRtdQK9lXkzXElhzRFSHk8FOxbZSAIR3kz40IVmLlvgbMHcSmSSmmPSLncevyPHwLF2uSx2H
+rZBxRtlWwD4YDQYPFoHxCe4ozp2sYKg3WfI9lT+CLFtbJ7hsYyoQYFWgBNU+kREIgkE0Clpty7XX
+Xc/H/skLdOdy87gJHvGJahV8Hjh7GQWDCYBsNT1ttd/h1YpO+NnWcjVHyFbqSC4YgIGu3Xcwg+PN
+HZp2X/w8Za9gOENixumM/AzGTLM78TxcGZhJHfRXs3kJaIZG6xQZuScA4U3dJOaqROfsUJQoGW+C
+fpQAOJVXtdRDLBji7MnLNpvWEuIFfcKNzSjBIXEEq1DMDL0WE5dhA0k8TvoyuqlrdW+zCIbt3apC
+VIFvqCZwUkQhpXaK1iipGxe9DzI+12TG3u6ANQHJxqgjWa2o5AkIV8vY1oqK2EUcK1YQBV6H0LIf
+72aLBT7QWZm4IlshXrvajrwUEedpwophv+7VXrR6dwa0Lw7kyzmR7ONjTpuhkylT5CpKVhZVqeQr
+5jXxt8E7A3M/SAREPuwMUjBXp2zPVLPaiLZDrBxA832xZc8arLVE0cRebVcJfBUdTwAgEXgqhbQO
+yevUtmuwPv3/FNvPCcJGdCREH2xhREkE9pB02lIrJ6mCNIVX09sqoWNWZewyUPJhq5fzyu3MgUJ5
+op8jqH8J/jwWtOVEAwWVkaP0Ps45gBybnNW+mskPCVnu/kcqG/B65Ra6lcjniSyMSgZxmsd0s/vM
+TM2qxCpKtgahXPmD3IjBBdVaQgAhE3G826dzXgrLciDO9KYSE+2Wiw+kYlucP9mEtT2xCk149+tF
+IwRCDaL6PKaTb1XawwNbPJMPSdnj1iUyWiyZVFrZkmHDjIhYwMTJJFOFT87g0Hg4CUxm/W7Ij5AX
+BmVsfrTV/axwaGFZcKwoV87JGzJ69kanQtB9ud+uXSyPnm16sR7EvLnVtOz0XsKk4cf+nGjTe/W2
+qqR89oYCSG9y1yDDCn8lt/0zYjvSGrUHmnCcvtijltoBgQHpdmL4MIuWW2qrGqjaEApzHiXga+kM
+y5+j7zZqBP8TydAkXF960rCS067mfKDilMlLWUoXrnqIK3ghr7At8/pigrRCmyT0O4g7RlGHXEhr
+oyttlV1wjx9JEGKzGO3F7To2bwdXyt8kejF89JZ+Chu+C/kxLOIFyqp4zCqZ7bETlNLx0gmQbe/W
+bBlmvZhoydZIOFlyReaEs0yDVQRm02a8KnE0KXKrhu5Lin71BmX3oFmQZg2Tu72NM4vCSz2V17CU
+PRFdeiWKCJdwyBDlmUquoG6eeZTJSsV4Md4bqiIBwSd3y11Vtz0kSIUVgNTkdJHDuMgK+NFn90eB
+DkNA7qN0oQRfoI7nKDBtsvkCcxk1Ng6iT2DR0jaSBFtfzGoylvK6h0mmZoXDggjao9XQLMqcHLL8
+anB3YCNI2yAquoOcma1O78CyI5I7/CHr3LoXDi932H7fXkS5BfzJ4K3JH7tZ9tAP46jBfCfj7I4v
+F/d9+Uhx4J4jd37FSeJlebJepVn4/caut19HqENVW6itTcGNPDAQwNsxlWdaJujepI3IYO9LnAGD
+JnMRbFcCP9dmcOE59aCmf84/06exEIZFC/1WKW5UGTfVYl692O7INnEWM2WKffJcg4E18buI+K/N
+EPj5orNcPevfWSmtF8riXtuZJ+P+YNg8aBbfg5s3Xunpj/6EP8Xh9dcvkcXL0xCrUooBZ11LvqU8
+SIKEouH8wyUZW8WzcnOlXh9zddwxwJkJbdjb5orbyq606T24Vm+iRIU5CjM5QrHdOSQyfyISKvIR
+9jk22/2YnBHEVsFybkQkEiun7V5DnYP3d3LyFT6W+KjfYSkPzi3vh2/427974Le/cJf9b2kqNRI/
+bXKYd7IwlQb61q58Wc43GTXQ7IGpnthf9M2rODAclhMUGUzFeqZOnMWvAhs8GlMuYzLXWc45Kabu
+vrz/Ns4M2zur0W2Fq0+5jTxX5MH7pV6dsZJQ2DMpF2aUotirR5RW8idS+kMCQp1iZiqAnmRbS5dx
+2yxU2QYizpCkSLKOba3wPBs97w9dTAUrRCcRvPwZ1zx5SRNxSXcyPxXHLg7mKbxGIxWNiRpJRYF2
+7877/n6QOhVSwiBJpR8MSK7AeCoSnY9EYpKo11R8ghVUyYhRJ6dK2rKtXBajYmvHhHe86r3UztZt
+VrO1R9bUCphRjK1x63RqX8dOn3EJFlOpFd8ksUFFLWJafznDg+08eTpid6YFZ+N+e6spYVebcnVn
+lqZSEhlTL+oeXMVB31vSFPPMDMvMUgW5JA/DZqjbWBIqoHctFg8083W32G8zvlVX2LPntztT22mh
+eqiD5mwOAGJn9DyCk2u5MVpMZVj2jvJwCNR1raEpmo05klRctjh7Nn32zD/QZEsnE3qlehe3t8iM
+s9ruTu1bs0fnM/2xWZnsoy7+GS6nuBWmUnkoz+XOFVNRk4MPVXb2hNacp87/ACJOg8026wQbBck/
+miR0rMWJF7Xjs1MhMDAPtgpZGT9eSo5ELVybmmPSi891m3riNucfsh1oZgkswraAkr+6PHUZ1010
+h2Z2KkW+Y2oLW57KKLXVlWhrptiHoz4vT14WsXPEhjw253vPajlcIUWVbSvdambKC47nZKZg98JU
+wDjTtZMQZLYTLU0lZMNRlHrKkfq/X3/fbv2H9X9/+/GRwj+NG8m+8ChRue/ze6ZSC1NpnCUajM5O
+VHUjtw/ro1NMHU4zU85uUa2a5dvUpaiBZc1GpKplu0B/Lk35M2RqIa9sGGQywREZremXhiTmVLSh
+cP/eE9mKKcfu5rF0H5XOlOM6FX4rXALMR7pp30hk1buxiQN7gYlYRG7BHQjfXwJUIfRGxrUTuyJ0
+tvQCp1uqvHbJVjtY+4NR65hhoraVnrCozesDs4mDBqSoGWny1szErEuc98QbtBkbNDQjunOfrBV8
+P5CnnopM58fc2XSS0P9bmjqaiylzRnpy0R76DonugDuml3vXMnxTUrvdUch8En6A6uS03qRcvUzG
+qOSYMdyBVxhfA3VLDWYbLvIsWm3TY8oO2Tm1iotMaZYhlNircdGdU+vW1Z79NJlKNos7bRg8+tR4
+S4mJLCQBV7LIufAy0Y8Ue1iJo8RXuBZu7qWB9yOeFKZZ2FTawWAl12l7baUWEGHJRLEpLwmgUC+F
+3epsBRRFbhlP4W6KKV+yWJzCL2VMDVwBGeipadmhiB4APCAJWWfc7TaNWq4yWmxTO3sm3Xfr2VEX
+UxNmyi4HNMqJotFC8vZTihJzMNLAYnsaVjyHfR9hD6Yc+R9CLy655VJ3jH4DiwonLqOm/F7yZDNS
+vL1bxzIeDY8WOp83wgOimPB1u2P6AfM4KTZI24t6HunxIJ/cwSbEE1RjO+XopQCxgnYZz6ezgGT1
+tM7N5NG+FIUR10sezp1q5mh2Cokn2ArLbVFn20bYJHHqrN5YVeTYSEGcfPU1VaT+1C97MzZBz/Ok
+vvbKcQ8bOG2+u/2Z7qD3fJuXnZlKaWayhC7gwYwdUy9tHS32Mg/BpLx1IswnCkSpYc/F+JJGoGjf
+SqZIBb6lVpeJQiQII0HbFivg6zBPW6BLuVWPyli3O2cyvFHjzJRJooRojrHSpMfuveduO32+UpGi
+hHnIBFNrsKN1Q7udllXqz6mESdOMh2tSSEmWXOJSA3LAFiBjd6uTr9vZ2Xr0KRUdlPOZoXdoyi4P
+LyvpFmTKfVITCnZyRpK9i6vuHa3ifKzbKkK7B6f8I52iOMwwalxKSeptaiavZuw69skpp3VnvSaR
+awpfrYEvWroDQZNfpzR8wsVxNN2ivs/7U3PCadUGbT4L8bitKhv3Ay1Thd1lZmp7i9NeuwD7JZ0r
+s1PLfwqw10zL1UrYOsQulFWLHXphOZ9oxRREkutoPAToM7Q1Em/Ooluea2tr35qZn5neKFpBGUYv
+k/YsUlRTE5uJl7Qv0YJIATv3FMMjanXF7SpTqQKmuFvs7lRTVjLtRusR2fbiP7FDbxDJQwne5C70
+VERtt6uPtXtB5Bk8A+cEYzwbA7clRq0kCG53TyRyYpRps7H2SdnqgeGkVmOy5PzowYJHgGGjKrLr
+MrseLDU51MB+5HiJIjOWZnbOTMXIpeaV5HESvJO7YtZOWzNtyN1nJFIojnQ46uIKMvhdmcT3nvaa
+EGHrTOEtMuXaAoct96yI2Lv04f5Of9ApJPQW0suHDs2Z/WvtoiOPtrRSwBGGrYeQb2qTpxyxHK0o
+MGvyN0sGGQKhYf2SqcUmErdg7nLp7uAWapNcOZueHe2e8q6bVo/Jc2Bl0mEgGjjRwgwGLkDT9r78
+NnkBmY/HH1NnIzaPzMprvSgJpiWhcrMUWpps2WdM2nlWlb5aEngMfGqmUJPOK0awnN/UZoGfzE3y
+/1o20CyoCMs4Ol1aeoMiI0xzaLKvlRBN9WVW5Ce6ojGB+8j8nA2qvK5KOlsC9FWR+MknLZo9PRG2
+ZEo8Te0hW/KVQwl0x45b797Km2fHlvXuFCwwErRun3nWwhfFVPB8NXYBJeSm8PlzLUcWIH9T/vvS
+FIbcVthW8+BgA9x4zYM23fNYwgc/Qu2/2DQ+uIba5favZN1nPAv1lv5idTrr0Vnn/R5ZCKICP3/v
+GuvI3/Ya+et5Vbq9+xeqRf8fvwcGgPaaHz79wUVyDIgFIfukvf+scy+Qb4B/XVd1vFd553d42VqA
+evCrrE/PmBfsr/aaYCN4eIoLIKuB//A7ZB0BnwOueZF8B6oxBzfCw+ka77IO/fHUK2STYG+8y3r4
+P2V1+0VWkj/rvfIeWQauTtXugzXgMqvR3yWnxEtTz4dK/WcSL8PD5IJ488NHcLeW5QCf9Mr+C14Z
+f5319WjPu7xrugNGKeuJy/x5ibXv/r303jsf/IiffpusBhe8Gu2xm2duvv7Rk+m3p6JCiFVcV1jJ
+5fVUbXWbquZSHdsbrDt6ldVkUXEXdUYv22eu2F8vTVVxqZ7qzM3LWXXSa/kn2Rq7pldVvaxqKq97
+Qo3fWfveo/7eCdZM6SpXbl5HdZc/w8OoAbNPPuHfe4PVY6enKtmiLahgi7a8fPM869/e8Jqth7MK
+spen/jrD530KdYP466MTba1W1ID5/c6zYuwtXZM9ecb+veaVXE+hCs7vp3q0c3aVa/Zd1bA96jVi
+Z4I1x/vziaye7WXUIbZ3z5/B7nQG9WvpveuqB4QomtmDAtQMF5MQpk6iUHrxA78zHdXNJIVJByr5
+lSiCwXaHDc1zpci5fLMVzFv6re5glZxUDD46s6dtiRWyREvTAeOpzJxZvtNb+XRyp2kDYesV0npT
+Id0ZgkhWIUy7SqhtpJ7g5wO6qPhhSlbmiZsmIjveKo9uKO/dKTJrLx0u4OkUKHFm+vCLS0V8vA0j
+0HmeCm3TF838tV2LU67qlOnfWd41nYVbmcrptM+7Ohg8WN8SQVz4FvrqG7Kd9+1ZzDVEQAfqOuZ+
+qfnpZAeO2cw7mkJ9zczkyWM7O5emRyVLv+HKnSlsQaet23BW6iIXYfEkaswreWwpLNlZnooaTltf
+S+bo6vNHB8NuMxfZ2kLpWhfwTqzdGXsqBCOmrTVr1dGq12tlC4qcxX3ndDDDe8XFsGcy1fYwaTz6
+m5TQR/WKK8a6sZeqVqYcnn3TqaqlYjoVH8kAt16+SM37RMk7HeL8vGdebTbeb909aYnm1Iw2bYF6
+IE+DRRCIE7nV8u4UU2ulhZtpxsOpbW3KLw+xegfHioMb4+HqRiZcjA9P+73Ed2WLR0CWDULNzIj7
+1q7Z3NLSWMfjNgnHqkv7NGsygOZ9zAoQppeWpvDLGdRjPOzNMsbcuqTNLd5PpOVkDk9VV/aTV5sF
+rNo0Be+b+T0ZJSA+33ouCoQVU0kVxo3a/ovqKvd8WkAvEZPTu9ny0aRpzalCKtSvg7wcl96faXhn
+Rn94s8H965LIZpgGp6T7njmRexZNEqdltspEaxayL4imTOXZQh583LoDRbDkMuSUhyGL3LezEc0R
+VIzD9HrlVtooWRPZRl+TdqXeleiMI2ZXJpmOvNMn2nLWjYh2Xpc6vbYGtqotDUjrWCCGcrru9O7p
+jVEk8MkvzMJk4cpmcaav9iW1aE+IwVue3oJykCNDoYOt+4eDrXK9DLZk12DOalAi+pqVfWCCiFbO
+XPn1PiChVR/xn8OIl9oztnln3egQ81JpVNqtilthVhi7bXNWfux2sE5O41mcCzTF+6dqqK3d0+ge
+xXMTnC4vdgk0dpsFn9pEd86Yg59LWaW5qk2GORHHS2KVaYa6JbGvboocZBxHjGo6F4tpGA6WRqa5
+I+bDYlaciLuSyqDEDNsjVeKHOfgLm1uqR8WKJt3HGdJ0iKApsQA9E4wc5zO+qYvB//NtZ4YBXcnp
+oBW6mHFSnQ6Kj+daXhd8+KIzVp163mlMnOHnh8GR8j7JQM45bY6/e8VZmJz1JW9VYpX5fhArJVKR
+4NrCfePzzqFkTX2SXzkfLDTvB7HMtYxbxtr8CDhG8JUf8co/Ju3JqYx16oWgynnOr+yXejE4c8TE
+8nx22Z+SGebpYFM5Hc1I/DBiQHo13j07RTrkzCfB/JOIwtKgeE+eclonp+R6mG+dDcqX1+PrJ4Lj
+5dWMAycooXCjP49W6b7ieHkrGv9C0JQ9GbxGp/iWaK9O8I5P8MpiDPt2EDqdCRKtl/n7j4OPKKd/
+EVvRT4MB5hn2jK78fNz3JOfhM2zne/j3FdJ5OcvQVafQwe+nog+fDWYqPdG5mD8/QGNwKbGBvcpL
+XY1BfNUntj/CtXje6EmfbM/wK4n56rUgO3o/aJQuR9ediud9lVRCL8Z3T8d1rsQYaW4kHrlXY9Ke
+CkqiF4IF6EzGcvZWTGbd4nisoEQ/lVh0TsVqejh2g8ezUXgvGIHEL/SSUw/hw8/FiL8erESnYy08
+4pfydfTtuOCL7Oe0n5xotwjvTDXphzHbg9fLryxun4vx4Zix3pOJNCy6Dh97zr+Ct0TZFFxe+PP1
+uNELsTWBRWrYNL+Ta2zsHk1nefhyzuU+SiBhptWnDe6ZpVtgGMVUXVmvXr7FKx55zei87eW81Pyq
+HUIPtpmtYvo82X2LSTsT109+dTntsu9avCU3BPRy18wdGSj7ilts1RafRHNoX/Cxeyi2uF24ZJRr
+94EyCl3HsKqXGImFRhooAqr9QuC13sItnt8M4Bi53liidQnt2W6X8Ll76HtUw4QfVkFs0dwyAAu3
+xAqcA9ks02ZiV9jsTJuQe0ckDt/f+Toy9w92lslJU7TIywztDTje4swtEYO5YjqbX9LEbjW7bnHW
+l9L9PcO4eEsHCKfQmqrE6uTlmcU04ilzflSXmelwwQoKVzN8zeTjkv+hs9y5xaOaKYqptCouvPc/
+ZYUdnm+6o1orzQ6bVUFXolApbp88JFVw56sHcdA3Rxv2/jfRf9/cBnw1cw2zXwV0ucUPyTCwwHk0
+OS84vnBYWackaZPrDasGR8vH7cR9jtRIBuRUyQiuyV7oD2QrdpblqrRYybzEkeNdTONBp2fXnj2+
+PnabeVhC8ENFmih5UXwo86NJOkLnP9PWgxbrKmbwqtzwzrTDv/suFtAyw1w2g/7cLcthR3P75Ei5
+joz/bLPrj/b811tM26X5W15oZ4ICZLexWbfd5n7DbVl+jB3oQaEUBGzxfY5r36LvURRL+uUWH2Tk
+4Yw0f1du2V8Sx7hErXf97LmfvW3/fetn7/7s2s+u/+zs/1fXtfVGdV3h54zEfziZKLKtemagRFWD
+xxMZmzRUSUCpUVL1oRrPjM3A2EPnjLGtqFKMgZKkhEQKJUJVQgoJjhuoA3EYCMZS+wfwG4/wUqn/
+onvd9l77wosvM+e6L2uvvda3vm/3HLABY8yc8iAPMNK9gxHwAUbg7yDXMUbYze/PMVMBkXzk+/Xz
+Hcz5e4++xbO/QIZg4BPexpzAl5hF2MJvKWNBjMrI+Yy//eN1BJ75lJkDd7B7HvMYkB3Y4uzEFmcF
+HuETXNo9xzy8xBoNOZgd5BteIzZb8/z8fruf8PttY74EmbLNGVt+e+DR2/is1D5bQXZk00X3FSce
+85KpuPZ5iHRzXFlF/OGnjV//oDIJa8DU9mSdz7/75D5w5tH1ntwCljK8g+Mmo7M+slx9F5DvTb7H
++yPf3obkETyes2/ME5ylT4JndJx3fL9ed6bb12ItNpdPudxizdVQMmFZNVyj0MYhyI0tXTipqpYY
+CtAyXbO4ItePmvs52WEVUAwnUlUrhWDxN0UMEPRCfxIIHrE6CxxZ40iu7EaLgXlz2DmL0F+ClGjL
+GXxUne8u4BTGySzqntkhkJTsGYtY9pgb9hS8SAvUKlf9zCisOhJz5ib03BaAxNtTuGOKIo9jf+sg
+Epke6RdB9fpcSeaIQuh9sC11cECuXRBvZrw4AaJ5o9kbrc5prHcdxX7RWRAXwuCTXRE77vzNYsOY
+f/MDQiO1quehgepTgE0GGTSIbtoaoChRMVRlNPR4cbE/W/p1sVZwKE0LSDzdqndUWBLCWNSbWd7C
+6Hi42mWhO4RAH4nrIcZEl1PBowOCY5Ykl2gZ8CtvEJCgisZV3Sri7mbby8Va0LV7ClBq0yo1QZed
+iDEoFgxvBgsqIBlnM4qjThqPyyxa9RxU5VvZyXYHkDU9oBIMXEfCGjX6dsRYPiQJwh093sUQVjbB
+irmi4GFfn3MCHfsuB1sLJ+qgXALsbifNg5hvWyVwsaDV5urzLbYfrpLHHPwuCUCxgpZoKGXiHBzt
+dRGE9Rvzz8IMVAxrIhwBzCIqB9mREPGiqMAIFoXoFK2mysxukhuRLYeLj0tMTSCYAg5x3r01fRhT
+zCtSmmbbg2WlwMfC6GPul0IBMog8VBfGRAqJRSjLg1KJdiP3mL8wZspgbxJvqs91eR7vkYm8J3QL
+CoAfy7TQsxkzo5m2CB4tFNzlrbbZCkEhb/YuZjJzVwPBttDB0HgcKGeGTJvHH4bt0SU4HIgkYfAc
+ZJ2oE5moUogpFJTJ30KUjWvXQKiYiCG1827HVsUgMlgXf0tA84jxiC3ZCVe5Ou3zNugvCh6F2AOo
+1sZeVEtgYz30PGLy2n1bVqCFeFlJxwwTVtgk0KItWqmAgiPorrX8pNREaKS9E2BVbIAoEuCeZWj3
+l7ol4/P2rDDj1CLL4QFSC1QJm/IBXK7X7jb9QoaMGsjfWDE/HuftOiseKaGQY1nmSVh2UZiwp+yH
+x1+HCFwcPRQvx6b2ODchb2E3JvWZHIgTzJ4sNMI2yH1A4uFjaldKG6WxE/VlM99nco4nVPaVXYpK
+qo2oM1Scu8LTWrs/6IKAlS8hEcl40djz0/WG2X4hihiWv9Zw8eX9k6rMbyh65toRpfuGIpqjaidI
+vtIvqw48KWupIt0wm+J6/4BZ0My6BXg0mYTR3C9EDlkeN5D2nog7J4tGnkNnisJjMQsdtWK0o1S8
+Znx7eKxXKp2usctcPdlUA5QGpKsEFK8A2ew6K4qMQ3QSXR3WWOQE6kQ54d08ckM8Hmoo6s0VJFmg
+ajQ/FQTrsPUvrKuaeQkV2usi5RhJqOKOvfri1JHJ6d8fPZRJV2jSHvpZrIUxKa+DMari4/3gZuOR
+owF4OiHbXOxAVWlgXIyt8KueoF/fqPfM8zZ1RMc5VVw1Ta4y1NdKMODIMkh76WWbvfKTrRXECpgZ
+w2+hRpraKXB95iwyTXRUDbEe4eSeZ5Hb7tdkYHVHtNCUo9Xbr6VAi96BcdJRyXVAruKot6wf4BP6
+lY3CHOXqTmRRI+mw2T4TFQojVaa1QJ17oSvcqIDOm6LgMDZ8tCjVCOqjoN/rWRhjmo6eOdrX9B2+
+GAJRrV63xyQ+ZiHuLOZIIOEVX2L0Rauz0W7PDDUqEbbcN5OHJzjc9LoZKzPd7kmLDXBMGQs0cuo2
+dyt1184oY9lra15V7yPTVr8D8bMGKcZS8tu8iYSPRqUvWFtYYdXJCuSM+D/S7yvVyFpkIicWsiDV
+rshOLN0nbGAWe84lmOwu0JA2bgJthmG0zBkvZzHnUrz8KIB9gbdjziXENara8gcBnYsDGBBIYN5V
+5+hMaIYbgnrzRB0xNXaMYdzLCwgbJz9nAiLLeqo4YYnpxlwXDa1NzB8nYlJhKMd6RkZK2HDrFEQe
+0YeSWmxLC5xRharQGulKJakidYzE5ERq3gSIR3Z7OqqGpWN0dSrAaiBtCA1gMzx6SPELBIcCO/M2
+BXZa2WgjeDfQnF3C/JjxZWEwWf1566DbKXlu7ZJptTzgQjDzlPFOCqFysAuWFwhhmD0vD2rIzJZO
+QQJ5ZAa18OCYUw0l9G+3D8UfuahtCgUT8PMo3nfcJ/jVv0TcHe4z7b2BQWC5fOr4qQBrZ0ZfZGgL
+jh1TqI/qQM7VRRPBe10/Sguyjj7GHGtRmQjK+fHTusIB313gdUW6eXFkzNH4tIlftuVB7bCJcVHN
+NEUcl2lhSIa41faOZUEyyucnwXGIKyzu9SrLJYgIq5twP2bRdl5/S1E0m36wf5DNxOc51UZOl8ef
+7X70+GfALqNeGOmUhZ/cIBUwRJKfh/guK7s9CFTYRNWOY5z+J7uXOKp6lxXeIK4Kcd0d/J8jw49/
+wDN3CFWOeOqH+BdFeTfN/Sgaew9R5RxjRoQ347r57hRR/hl1/UjXjO4OuO8H/BbB80BMGSO42xwx
+/uvuJ4g8P4e4+QHHekHZ7geOJm8j+nsbNdq28P70pnAM4fDlkwf0FqzSBnf/FI9/gJpy26Dyh/nW
+iwIY2JSE7AXMqK5ibvoOZnspv2wBDEp3jCWczskp30q69r6k1y9KtppSul8rratvFRKA8t1agUvQ
+EfC5nAV556v491eM4mAtIUJcXFXJ3zMq7b6Oz7Cq1H8eqfeyaBn7FgO5zmd4yh3RPlsXcMim4Ao+
+wOtvct6csQSEqyFZnFsCEXkor3kBT9FnUcuoduY23JBTlAqPwxhclHy6BlfsKOTGhjTyh9IR9xU+
+R7WhA5BYLIGGgjySrhc0gkPj/N1BCDzNu1WFQvlJIWeuS4vtSO/cE1CEAhd5SI+LooG1gX/sIMLk
+Ees3uac9I2p6hB656kNT7sjBN/HEM+5N3b02pEcu41m3GaDCYnw0wG5Km9wWBS5quhsisyWabnz3
+297oZT27C9iSHyBwhWAMHwsoiETu6Hn+ptr/vDzeX2Q823b+nnEpDlb0UEbvukyfdZGgsmNMUDfu
+E+qmddXOW+46BA7hebEuM84Kb63jE/5LicGhYiD8e9teJ84jebUpriJlzRy1jtUcG7aqQdSM4Jj7
+5ve/PHUe94noNIX5oe+4TuQCZqh+xCwVXRlqWtZRsYifRzSO1Cd3Mdt11mamvsfnWoVqDtbxuRne
+C4/dkr/UhlJCMEv7y93eXGXfq6++WllGEh/YI4RBIdz2wrdjmU0FRFEYYW1S+hxFYG8R1u/xYnd2
+tqgp7ZjGbwzkNiBVL6B/47FEAaXspaA+BNxAnbLiHI71sl4qpgR8+GQt6MEfFapRPqZSOfR2MdM3
+XALSkmbr2DuHJ6HEdAG9Jb7hCeN10yUOhIln1BgPcip5w0Z1MhuP03RWfNss9u7i/bjnkPGN467N
+wAsNIlqSqMB2p0BUJdG3ceAJWwI7mZJCr9UKS1Ea5LUg9YaxFcz1e9fKoh3rgTjeUsBooafu0a/G
+IaksHSsJwOwqMCMn++MbX8qTkCBWB6/gHPnCMp/CwIXsvYC/LseWhg6fCcLVPmQZTlbkSzJEsyiX
+uM/WmWBEzkyEuRnQ8+aggNlLzwGiJZoI7XkBVaktzESj3mzNU1yCkCO5iiQJqkfvKvnxy2bQ8Cbi
+4Mrh5nC7OUJMcicEmA1bYtlmCLXXyBgzznh9G7voBa+fKUZWiyPGglVuuSoXhomj8AVzYGhNB05d
+exlw+hlbjranBkFhxYArjOgGoOzZE36AVBzs/hfm+kCsOFWeLMfjvkQ8DMIFAYGNUS1NJuweblfY
+VOXWQdmQSphJqJMrxCD/J/HHLA5AzrdzX56tO2t5qkaz47wpzOPo5qQxY/Mz7bq6WGvZDEgmReHi
+lMWc1AlUu3Eg0Mv+QrjGD9rVZ2dBdKGvXgix59zGPK1c8E4xxEUhcAmswHGH4RILTQ115JnmsnB2
+t60lA3hyH5Hc5VJrxrxzC5lF6pBs7PVd0SKtdhADskk3wBlJXNFmW4UEwbJcvM6RXfXIDGXSCkDM
+Cq+HydtmP+z6i9gfdIlTHe1Oo36qLoIkjgVNB269IQelKTTkZhd7OE6VHXPtRSoGUN8hchbOlEII
+bMW0wRwQwBGxWdxBVT819F7p2ETp1PGVnNAOVqQGcIBmSQUTJqPPxqYa8EUD0HbD8houOYpmABse
+cSP0jjmzctg4F9ecWY8GVHl0+IcCkSB11UOOKZgmmM7AfmyAtmAPiCKgXV7ef+jl/ZM8xsw/xZGR
+sTAiBSukR1IoMWwzrP4Y1D2G+FqAWQZGGqGXcZ7tvRLwyE6ZmTQ8AkZ7uj1v/sriEEzRW1bYs3Ae
+kvN5goJcVKWz76BcI6SqldnF9lyOG1Jt4JfOMt7YwnFUFi/hMnGEXtunvjhI+s5Mzeq7IqCstzSN
+HMN1WK5XeOhN2tJI7AGtdyeGxwPf8o187g4u6glwzkxbMderzyP+xSIgtMCRgJfs9RzawiusleMS
+K3a8fP6pWE04NGo5sj6ql7/go6mVg576Va0KL6qHsDDK6RGITl1QiR3irbE+N4szycXEupnw3orU
+l32vL/OE57enED9fLTXw2RiIIB3oj40lFg3HGOYEU6xylaiTtEHMgGUbNAabMP8ewCrIfFbhp6cm
+wXkxeh+f/VhNvdPddnN478hY0WMct6XO8VbN5Ryc6a4lXG4ff0h/BRXqCC4hDBRqjhLnHbyvYraS
+yVCNg8wNzmrDytFpz2Lzjno6iAJGiU1Y5jgo4ThyD5rtUx0iFrUMNrnj9XHviwMjwL9liTbg5HUu
+Pg7mFxPuo6d2pVw3T66LOL6iHR1JqWG22/6lpZtElcKM4H6YWTE+7kkaiW5sNUUsgu2XBZ2JIBK3
+qdXqUcdxXsvbHgUF0gglwD49DTgioEFuATtSTp7dKch3WqarYbtBdnsI1VY2I+pYsJ1/lOiiWpbY
+QCIGh0AJCByCZyP5Mh8OgSMSZYEcvlKpU6G/stTOAUuH2XSchlKeDPYluLlfeEnzkvTDWphTbDfp
+/fiF4B+bdbRZNbVAQId0583IVY6XJ5fIPTCbsC+Ix2RZKMYHIZn+4tzifzbybJhy64sjwoeze+Xx
+9u4qZjs2MYcQfPb4GmYNVpEjiHIj98PcBSHMzTlnMQvwUPIp5nqSBXE5hG3iDJLsA3DrxOcyVj78
+7BplUcx1AcM+sBh9yodsWVae+Hqf0PPCEzuuHXN/YgfaxM+QD0f4TTDm9z0qr5/n+NqPHClcU9jx
+M/TZk1vwmb9GHZt+HWxJTt6XMZSqFsYv3WU5Vs/qyGhCWpPxbAjHmhj7yOyARxVEsig6lKf8rGJq
+MVAYcWeSrDdSUO4ITrdw+lVTTop86Xk4ripEmMCMxxX5jHDhDDjI242T3io3LJ6tcmz/rAhDxdMp
+ZkfRdR/K9b4qy1IOeehoozcfLdNUkuItXfQrtO3qyODnS8D7CdPxfTOVS1y2VUj5uERaU/PJa2jx
+CsIRcmhBHxu4FIXqi6XSH3wCj4l2D56kVki0SMozXXo6uPZ0cOfp4Dv4ef/s08GVp4N/PFv75tna
+p8/Wrj1bu/Js7fqztavP1r74763r//vn57bk/P6q+W9E8mKbkrygLMPXGLf/kFNgnOiRrA1XXF6U
+dJutKg0yODY9YZMCtgp1Q4pAB5yFhLMuu0JRVztsK4tt8ewVlb4JEoIfSxH0juSwvpTHOyf1pJsq
+yWirhm2+cl0KfnXiZjUqzb7MFaacFNP5rKBM+4YU817konVuk3Wsn92RrzYkQXZZjtyRlN9X6nRq
+nzP4zPrDDane9avg+Wk3VRbyhsoQ2X6ns67LibelVc3dv/aES+0uOEYTAv5AuRGqLC0a9vCLdyeh
+BwFkK+o2Un+DBivcX6ZW/WIttIE0XRJx+eX5jjrWGbOAXkqsp+KkUbsZswokfKiUP1yphSEN2tWU
+05Zb7TjdpNeG1FnXGH8J5jPYhnI1qLLuaotXqlTe3T9ZqUxNT2XvvTH91pvZvvLezN90SUQm2PAw
+VZZ5XOZpwq0b1EiYhip4q6ec4iIZvzWN/jtu9FQ75gfnUvu/LGgs3tHiXYaC3hyKY1AIIPKvwP2a
+JZes8aFfJDJQ1eSSWYBEz2jWB+ZOePVRTL2pqi9VjubXkcs4S5r+9ATwlzPrIKQiRabne+16ibrA
+vHlv0WyU/n2vSm0fzoPO+N4xqkNX7tH7auOPQ/tABsxIyYF6/IUXUvG0eh8U5MMnqSWX/+RbpNfV
+VrIZxnvJ27XGU86K9sab3exgzzjpnRHFtThA3kbC8txBTBD4yD85/I7HO3lHqjGx9vIusUcqTFPi
+CuZTuMIl4ocEhA/VnvrWMzt67OCbhyez4kK/NB0QGUD3xTGVSfgeojEMO9P5VbLExhAu5Lrt0+Zg
+2vxLtnNfqe9dULm20+/wIZWEh2xm45iugFRLC4aK+m+bSVamupWDCH9N2o+F+ok8HrPmSnY7O1JG
+PDwP3tiHxRNwDMdrTRx1xK9TkS6zu1Wrgnbqq+nVIo5a4UOpNURT7iUwAThL0pYyjo5gI9fLyc1K
+slnnW8fTr8kKUeFo0eMEh8kr5b37sml/9bcJx+fcs/qc7ilm6RUs8fKY9hxPtmF/eChLrwvPaa9i
+M+3E5IAM7iTgEPH709yopt0TyCcnv8C5VI5bOprLPPMDN8Iujs/pwsJzmrOastPwXs9pthqiMy8p
+C8XWizCYUjvPu36HfBwwitLWoQP6Em3lDiIa7XUEazYQd9V665cF7XVFwcoGPsbtY8UKQ979TQVc
+soi8HUXws8PXTLjztHG5jBRThNvaFKzWV6l9xoa/E7ooJE+PEPk18L17xo79Hw==
+`
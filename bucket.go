@@ -0,0 +1,184 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+)
+
+// Bucket is a bucket accessed with the credentials, region and HTTP client
+// of an S3 configuration. The bucket operations of S3, e.g. Object and List,
+// are available on a Bucket.
+type Bucket struct {
+	S3
+}
+
+// WithBucket returns the bucket with the specified name, which shares the
+// credentials, region and HTTP client of s3. It can't be called Bucket
+// because of the Bucket field.
+func (s3 *S3) WithBucket(name string) *Bucket {
+	s3.clockSkew()
+	b := &Bucket{S3: *s3}
+	b.S3.Bucket = name
+	return b
+}
+
+// Name returns the name of the bucket
+func (b *Bucket) Name() string {
+	return b.S3.Bucket
+}
+
+type createBucketConfiguration struct {
+	XMLName            xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CreateBucketConfiguration"`
+	LocationConstraint string
+}
+
+// CreateBucket creates the bucket in the configured region with the canned
+// ACL. An empty ACL creates a private bucket.
+//
+// http://docs.aws.amazon.com/AmazonS3/latest/API/RESTBucketPUT.html
+func (s3 *S3) CreateBucket(acl ACL) error {
+	var body io.Reader
+	if r := s3.Region; r != "" && r != defaultRegion {
+		// us-east-1 is the default and can't be specified
+		b, err := xml.Marshal(createBucketConfiguration{LocationConstraint: r})
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := s3.newRequest(context.Background(), "PUT", "", body)
+	if err != nil {
+		return err
+	}
+	if acl != "" {
+		req.Header.Set("X-Amz-Acl", string(acl))
+	}
+
+	resp, err := s3.send(req, 200, "error creating bucket")
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// BucketExists checks if the bucket exists. If S3 responds with a status
+// other than 200 or 404, e.g. 403 for a bucket owned by another account, an
+// error is returned.
+func (s3 *S3) BucketExists() (bool, error) {
+	req, err := s3.newRequest(context.Background(), "HEAD", "", nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := s3.send(req, 0, "")
+	if err != nil {
+		return false, err
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case 200:
+		return true, nil
+	case 404:
+		return false, nil
+	}
+	return false, newS3Error(resp, "error checking bucket (%s)", http.StatusText(resp.StatusCode))
+}
+
+// DeleteBucket deletes the bucket, which must be empty
+func (s3 *S3) DeleteBucket() error {
+	req, err := s3.newRequest(context.Background(), "DELETE", "", nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s3.send(req, 204, "error deleting bucket")
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Location returns the region of the bucket. Buckets in us-east-1 are
+// reported without a location constraint, and legacy buckets in eu-west-1 as
+// "EU".
+//
+// http://docs.aws.amazon.com/AmazonS3/latest/API/RESTBucketGETlocation.html
+func (s3 *S3) Location() (string, error) {
+	req, err := s3.newRequest(context.Background(), "GET", "?location", nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := s3.send(req, 200, "error getting bucket location")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		LocationConstraint string `xml:",chardata"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", err
+	}
+
+	switch result.LocationConstraint {
+	case "", "US":
+		return defaultRegion, nil
+	case "EU":
+		return "eu-west-1", nil
+	}
+	return result.LocationConstraint, nil
+}
+
+// getConfig decodes the bucket configuration subresource query into v. If
+// the bucket has no configuration, S3 responds with the error code missing
+// and v is left unchanged.
+func (s3 *S3) getConfig(query, missing string, v interface{}, serr string) error {
+	req, err := s3.newRequest(context.Background(), "GET", query, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s3.send(req, 0, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if c := resp.StatusCode; c != 200 {
+		e := newS3Error(resp, "%s (%s)", serr, http.StatusText(c))
+		if c == 404 && e.Code == missing {
+			return nil
+		}
+		return e
+	}
+	return xml.NewDecoder(resp.Body).Decode(v)
+}
+
+// putConfig sets the bucket configuration subresource query to v. S3
+// requires a Content-MD5 header for configurations.
+func (s3 *S3) putConfig(query string, v interface{}, serr string) error {
+	b, err := xml.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	req, err := s3.newRequest(context.Background(), "PUT", query, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-MD5", contentMD5(b))
+
+	resp, err := s3.send(req, 200, serr)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
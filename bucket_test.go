@@ -0,0 +1,153 @@
+package s3
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestLocation(t *testing.T) {
+	var constraint string
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if x := r.Method + " " + r.URL.RequestURI(); x != "GET /?location" {
+			t.Error(x)
+		}
+		if constraint == "" {
+			w.Write([]byte(`<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/"/>`))
+			return
+		}
+		w.Write([]byte(`<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/">` + constraint + `</LocationConstraint>`))
+	}))
+
+	for _, v := range []struct {
+		constraint, region string
+	}{
+		{"", "us-east-1"},
+		{"US", "us-east-1"},
+		{"EU", "eu-west-1"},
+		{"ap-northeast-1", "ap-northeast-1"},
+	} {
+		constraint = v.constraint
+		region, err := s3.Location()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if region != v.region {
+			t.Fatal(v, region)
+		}
+	}
+}
+
+func TestCreateBucket(t *testing.T) {
+	var req, body, acl string
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req = r.Method + " " + r.URL.RequestURI()
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		acl = r.Header.Get("X-Amz-Acl")
+	}))
+
+	for _, v := range []struct {
+		region string
+		acl    ACL
+		body   string
+	}{
+		{"", "", ""},
+		{"us-east-1", Private, ""},
+		{"eu-west-1", PublicRead, `<CreateBucketConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><LocationConstraint>eu-west-1</LocationConstraint></CreateBucketConfiguration>`},
+	} {
+		s3.Region = v.region
+		if err := s3.CreateBucket(v.acl); err != nil {
+			t.Fatal(err)
+		}
+		// the global endpoint uses path-style requests
+		if x := req; x != map[bool]string{true: "PUT /bucket/", false: "PUT /"}[v.region == ""] {
+			t.Fatal(x)
+		}
+		if body != v.body {
+			t.Fatal(body)
+		}
+		if acl != string(v.acl) {
+			t.Fatal(acl)
+		}
+	}
+}
+
+func TestDeleteBucket(t *testing.T) {
+	status := 204
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if x := r.Method + " " + r.URL.RequestURI(); x != "DELETE /" {
+			t.Error(x)
+		}
+		w.WriteHeader(status)
+		if status == 409 {
+			w.Write([]byte(`<Error><Code>BucketNotEmpty</Code><Message>The bucket you tried to delete is not empty</Message></Error>`))
+		}
+	}))
+
+	if err := s3.DeleteBucket(); err != nil {
+		t.Fatal(err)
+	}
+	status = 409
+	if e, ok := s3.DeleteBucket().(*S3Error); !ok || e.Code != "BucketNotEmpty" {
+		t.Fatal(e)
+	}
+}
+
+func TestBucketExists(t *testing.T) {
+	for _, v := range []struct {
+		code   int
+		exists bool
+		err    bool
+	}{
+		{200, true, false},
+		{404, false, false},
+		{403, false, true},
+	} {
+		s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if x := r.Method + " " + r.URL.RequestURI(); x != "HEAD /" {
+				t.Error(x)
+			}
+			w.WriteHeader(v.code)
+		}))
+		exists, err := s3.BucketExists()
+		if exists != v.exists || (err != nil) != v.err {
+			t.Fatal(v, exists, err)
+		}
+		if e, ok := err.(*S3Error); v.err && (!ok || e.StatusCode != 403) {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestWithBucket(t *testing.T) {
+	var hosts []string
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hosts = append(hosts, r.Host+r.URL.Path)
+		w.Header().Set("Content-Length", "0")
+		w.Header().Set("Last-Modified", "Wed, 12 Oct 2009 17:50:00 GMT")
+	}))
+	s3.Region = "eu-west-1"
+
+	a := s3.WithBucket("a")
+	b := s3.WithBucket("b")
+	if a.Name() != "a" || b.Name() != "b" || s3.Bucket != "bucket" {
+		t.Fatal(a.Name(), b.Name(), s3.Bucket)
+	}
+	if a.Client != s3.Client || b.Client != s3.Client || a.Region != "eu-west-1" {
+		t.Fatal("configuration not shared")
+	}
+
+	for _, o := range []Object{a.Object("key"), b.Object("key"), s3.Object("key")} {
+		if _, err := o.Stat(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if x := strings.Join(hosts, ","); x != "a.s3.eu-west-1.amazonaws.com/key,b.s3.eu-west-1.amazonaws.com/key,bucket.s3.eu-west-1.amazonaws.com/key" {
+		t.Fatal(x)
+	}
+	if x := b.Object("key").S3().Bucket; x != "b" {
+		t.Fatal(x)
+	}
+}
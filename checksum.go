@@ -0,0 +1,159 @@
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ChecksumAlgorithm is an additional checksum algorithm verified by S3
+type ChecksumAlgorithm string
+
+const (
+	ChecksumSHA256 ChecksumAlgorithm = "SHA256"
+	ChecksumCRC32C ChecksumAlgorithm = "CRC32C"
+)
+
+// ErrChecksumMismatch is returned if the checksum of downloaded data doesn't
+// match the checksum stored by S3
+var ErrChecksumMismatch = errors.New("s3: checksum mismatch")
+
+// header returns the name of the checksum header, e.g.
+// "X-Amz-Checksum-Sha256"
+func (a ChecksumAlgorithm) header() string {
+	return http.CanonicalHeaderKey("X-Amz-Checksum-" + strings.ToLower(string(a)))
+}
+
+func (a ChecksumAlgorithm) newHash() hash.Hash {
+	switch a {
+	case ChecksumSHA256:
+		return sha256.New()
+	case ChecksumCRC32C:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	}
+	return nil
+}
+
+// checksum returns the base64 encoded checksum of b, or "" if a is not a
+// supported algorithm
+func (a ChecksumAlgorithm) checksum(b []byte) string {
+	h := a.newHash()
+	if h == nil {
+		return ""
+	}
+	h.Write(b)
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// Checksum returns the base64 encoded checksum of the object, which is only
+// returned if it was requested. Checksums of multipart uploads are checksums
+// of the part checksums and end in "-<number of parts>".
+func (h Header) Checksum(a ChecksumAlgorithm) string {
+	return http.Header(h).Get(a.header())
+}
+
+func (o *object) HeadWithChecksum() (Header, error) {
+	return o.head(context.Background(), "", true)
+}
+
+func (o *object) ReaderWithChecksum() (io.ReadCloser, http.Header, error) {
+	req, err := o.newRequest(context.Background(), "GET", "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("X-Amz-Checksum-Mode", "ENABLED")
+
+	resp, err := o.s3.send(req, 200, "error creating reader")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// only checksums of whole objects can be verified
+	for _, a := range []ChecksumAlgorithm{ChecksumSHA256, ChecksumCRC32C} {
+		sum := Header(resp.Header).Checksum(a)
+		if sum != "" && !strings.Contains(sum, "-") {
+			return &checksumReader{rc: resp.Body, h: a.newHash(), sum: sum}, resp.Header, nil
+		}
+	}
+	return resp.Body, resp.Header, nil
+}
+
+func (o *object) ReaderVerifyETag() (io.ReadCloser, http.Header, error) {
+	r, h, err := o.Reader()
+	if err != nil {
+		return nil, nil, err
+	}
+	etag := strings.Trim(Header(h).ETag(), `"`)
+	if strings.Contains(etag, "-") || len(etag) != 2*md5.Size ||
+		Header(h).ServerSideEncryption() == EncryptionKMS ||
+		h.Get("X-Amz-Server-Side-Encryption-Customer-Algorithm") != "" {
+		return r, h, nil
+	}
+	return &etagReader{rc: r, h: md5.New(), etag: strings.ToLower(etag)}, h, nil
+}
+
+// etagReader verifies the MD5 of rc against the ETag at EOF
+type etagReader struct {
+	rc       io.ReadCloser
+	h        hash.Hash
+	etag     string
+	mismatch bool
+}
+
+func (r *etagReader) Read(p []byte) (int, error) {
+	n, err := r.rc.Read(p)
+	r.h.Write(p[:n])
+	if err == io.EOF && hex.EncodeToString(r.h.Sum(nil)) != r.etag {
+		r.mismatch = true
+		err = ErrChecksumMismatch
+	}
+	return n, err
+}
+
+func (r *etagReader) Close() error {
+	err := r.rc.Close()
+	if r.mismatch {
+		return ErrChecksumMismatch
+	}
+	return err
+}
+
+// checksumReader verifies the checksum of rc at EOF
+type checksumReader struct {
+	rc  io.ReadCloser
+	h   hash.Hash
+	sum string
+}
+
+func (r *checksumReader) Read(p []byte) (int, error) {
+	n, err := r.rc.Read(p)
+	r.h.Write(p[:n])
+	if err == io.EOF && base64.StdEncoding.EncodeToString(r.h.Sum(nil)) != r.sum {
+		err = ErrChecksumMismatch
+	}
+	return n, err
+}
+
+func (r *checksumReader) Close() error {
+	return r.rc.Close()
+}
+
+// MultipartETag returns the ETag of an object uploaded in parts with the MD5
+// sums partMD5s, without quotes. It is the hex encoded MD5 of the
+// concatenated part MD5s, followed by a dash and the number of parts.
+func MultipartETag(partMD5s [][]byte) string {
+	h := md5.New()
+	for _, sum := range partMD5s {
+		h.Write(sum)
+	}
+	return hex.EncodeToString(h.Sum(nil)) + "-" + strconv.Itoa(len(partMD5s))
+}
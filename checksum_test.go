@@ -0,0 +1,195 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/md5"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestChecksum(t *testing.T) {
+	for _, v := range []struct {
+		a      ChecksumAlgorithm
+		header string
+		sum    string
+	}{
+		{ChecksumSHA256, "X-Amz-Checksum-Sha256", "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek="},
+		{ChecksumCRC32C, "X-Amz-Checksum-Crc32c", "yZRlqg=="},
+	} {
+		if x := v.a.header(); x != v.header {
+			t.Fatal(x)
+		}
+		if x := v.a.checksum([]byte("hello world")); x != v.sum {
+			t.Fatal(v.a, x)
+		}
+	}
+	if x := ChecksumAlgorithm("MD4").checksum(nil); x != "" {
+		t.Fatal(x)
+	}
+}
+
+func TestPutChecksum(t *testing.T) {
+	s3, f := newFakeS3(t)
+	o := s3.Object("key")
+
+	err := o.Put(strings.NewReader("hello world"), 11, WithChecksum(ChecksumSHA256))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := f.lastHeader().Get("X-Amz-Checksum-Sha256"); x != "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek=" {
+		t.Fatal(x)
+	}
+
+	if _, err := o.Head(); err != nil {
+		t.Fatal(err)
+	}
+	if x := f.lastHeader().Get("X-Amz-Checksum-Mode"); x != "" {
+		t.Fatal(x)
+	}
+
+	h, err := o.HeadWithChecksum()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := f.lastHeader().Get("X-Amz-Checksum-Mode"); x != "ENABLED" {
+		t.Fatal(x)
+	}
+	if x := h.Checksum(ChecksumSHA256); x != "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek=" {
+		t.Fatal(x)
+	}
+
+	r, _, err := o.ReaderWithChecksum()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil || string(b) != "hello world" {
+		t.Fatal(string(b), err)
+	}
+
+	// corrupted data
+	f.get("/key").body[0] = 'H'
+	r, _, err = o.ReaderWithChecksum()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ioutil.ReadAll(r)
+	r.Close()
+	if err != ErrChecksumMismatch {
+		t.Fatal(err)
+	}
+}
+
+func TestReaderVerifyETag(t *testing.T) {
+	s3, f := newFakeS3(t)
+	f.put("/key", []byte("hello world"), nil)
+	o := s3.Object("key")
+
+	r, _, err := o.ReaderVerifyETag()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil || string(b) != "hello world" {
+		t.Fatal(string(b), err)
+	}
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// corrupted data
+	f.get("/key").body[0] = 'H'
+	r, _, err = o.ReaderVerifyETag()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(r); err != ErrChecksumMismatch {
+		t.Fatal(err)
+	}
+	if err := r.Close(); err != ErrChecksumMismatch {
+		t.Fatal(err)
+	}
+
+	// multipart ETags and SSE-C objects are not verified
+	for _, v := range []struct{ etag, sseC string }{
+		{md5ETag([]byte("x")) + "-2", ""},
+		{md5ETag([]byte("x"))[:30] + "-2", ""},
+		{md5ETag([]byte("x")), "AES256"},
+	} {
+		h := f.get("/key").header
+		h.Set("ETag", `"`+v.etag+`"`)
+		h.Set("X-Amz-Server-Side-Encryption-Customer-Algorithm", v.sseC)
+		r, _, err = o.ReaderVerifyETag()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ioutil.ReadAll(r); err != nil {
+			t.Fatal(v, err)
+		}
+		if err := r.Close(); err != nil {
+			t.Fatal(v, err)
+		}
+	}
+}
+
+func TestWriterChecksum(t *testing.T) {
+	var complete []byte
+	f := newFakeServer()
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case r.Method == "POST" && has(q, "uploads"):
+			if x := r.Header.Get("X-Amz-Checksum-Algorithm"); x != "CRC32C" {
+				t.Error(x)
+			}
+		case r.Method == "PUT":
+			if x := r.Header.Get("X-Amz-Checksum-Crc32c"); x != "yZRlqg==" {
+				t.Error(x)
+			}
+		case r.Method == "POST":
+			complete, _ = ioutil.ReadAll(r.Body)
+			r.Body = ioutil.NopCloser(bytes.NewReader(complete))
+		}
+		f.ServeHTTP(w, r)
+	}))
+
+	w := s3.Object("key").WriterWithOptions(UploadOptions{Checksum: ChecksumCRC32C, SinglePutThreshold: -1})
+	if _, err := w.Write([]byte("hello world")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if x := string(complete); !strings.Contains(x, "<ChecksumCRC32C>yZRlqg==</ChecksumCRC32C>") || strings.Contains(x, "SHA256") {
+		t.Fatal(x)
+	}
+}
+
+func TestMultipartETag(t *testing.T) {
+	sum := func(s string) []byte {
+		b := md5.Sum([]byte(s))
+		return b[:]
+	}
+	if x := MultipartETag([][]byte{sum("hello "), sum("world")}); x != "e09e4fd6265b36115fe3db32df945d84-2" {
+		t.Fatal(x)
+	}
+	if x := MultipartETag([][]byte{sum("x")}); x != "9affad555af89da9b0bfcd5e45bc93da-1" {
+		t.Fatal(x)
+	}
+
+	// matches the ETag of an upload
+	s3, f := newFakeS3(t)
+	data := bytes.Repeat([]byte("0123456789"), MinPartSize/10+1)
+	w := s3.Object("key").Writer()
+	w.Write(data)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	want := MultipartETag([][]byte{sum(string(data[:MinPartSize])), sum(string(data[MinPartSize:]))})
+	if x := f.get("/key").header.Get("ETag"); x != `"`+want+`"` {
+		t.Fatal(x, want)
+	}
+}
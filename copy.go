@@ -0,0 +1,258 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CopyOptions holds optional settings for server-side copies
+type CopyOptions struct {
+	// ReplaceMetadata replaces the Content-Type and metadata of the source
+	// with ContentType and Metadata. Otherwise they are copied from the
+	// source.
+	ReplaceMetadata bool
+
+	// ContentType is the content type of the copy. If empty, it is detected
+	// from the key extension.
+	ContentType string
+
+	// Metadata is stored as x-amz-meta-* headers with the copy
+	Metadata map[string]string
+
+	// IfMatch and IfNoneMatch copy the source only if its ETag matches or
+	// doesn't match. Otherwise ErrPreconditionFailed is returned.
+	IfMatch     string
+	IfNoneMatch string
+
+	// IfModifiedSince and IfUnmodifiedSince copy the source only if it was
+	// or wasn't modified since the time, if not zero. Otherwise
+	// ErrPreconditionFailed is returned.
+	IfModifiedSince   time.Time
+	IfUnmodifiedSince time.Time
+}
+
+// setConditions sets the x-amz-copy-source-if-* headers
+func (opts *CopyOptions) setConditions(h http.Header) {
+	if v := opts.IfMatch; v != "" {
+		h.Set("X-Amz-Copy-Source-If-Match", `"`+strings.Trim(v, `"`)+`"`)
+	}
+	if v := opts.IfNoneMatch; v != "" {
+		h.Set("X-Amz-Copy-Source-If-None-Match", `"`+strings.Trim(v, `"`)+`"`)
+	}
+	if t := opts.IfModifiedSince; !t.IsZero() {
+		h.Set("X-Amz-Copy-Source-If-Modified-Since", t.UTC().Format(http.TimeFormat))
+	}
+	if t := opts.IfUnmodifiedSince; !t.IsZero() {
+		h.Set("X-Amz-Copy-Source-If-Unmodified-Since", t.UTC().Format(http.TimeFormat))
+	}
+}
+
+func (o *object) CopyFrom(sourceKey string) error {
+	return o.CopyFromObject(o.s3.Object(sourceKey))
+}
+
+func (o *object) CopyFromObject(src Object) error {
+	return o.CopyFromWithOptions(src, CopyOptions{})
+}
+
+func (o *object) MoveTo(destKey string) error {
+	return o.MoveToObject(o.s3.Object(destKey))
+}
+
+func (o *object) MoveToObject(dst Object) error {
+	if dst.S3().Bucket == o.s3.Bucket && dst.Key() == o.Key() {
+		return errors.New("s3: can't move an object to itself")
+	}
+	if err := dst.CopyFromObject(o); err != nil {
+		return err
+	}
+	if err := o.Delete(); err != nil {
+		// the source may have been deleted despite the error, e.g. if the
+		// response was lost. Then the copy is the only one left.
+		exists, xerr := o.Exists()
+		if xerr != nil {
+			return errors.Join(err, xerr)
+		}
+		if !exists {
+			return nil
+		}
+		// leave only the source
+		if rerr := dst.Delete(); rerr != nil {
+			return errors.Join(err, rerr)
+		}
+		return err
+	}
+	return nil
+}
+
+// maxCopySize is the largest source that can be copied in a single request.
+// Larger sources are copied with a multipart upload. It is a variable so
+// tests can replace it.
+var maxCopySize int64 = 5 << 30
+
+// copyPartSize is the part size of multipart copies
+var copyPartSize int64 = 1 << 30
+
+func (o *object) CopyFromWithOptions(src Object, opts CopyOptions) error {
+	if !opts.ReplaceMetadata && (opts.ContentType != "" || len(opts.Metadata) > 0) {
+		return errors.New("s3: copying with a content type or metadata requires ReplaceMetadata")
+	}
+
+	// a missing source is reported by the copy request
+	info, err := src.Stat()
+	if err != nil && err != ErrNotFound {
+		return err
+	}
+	if err == nil && info.Size > maxCopySize {
+		return o.copyMultipart(src, info, opts)
+	}
+
+	req, err := o.newRequest(context.Background(), "PUT", "", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Amz-Copy-Source", copySource(src))
+	opts.setConditions(req.Header)
+	if opts.ReplaceMetadata {
+		req.Header.Set("X-Amz-Metadata-Directive", "REPLACE")
+		uopts := UploadOptions{ContentType: opts.ContentType, Metadata: opts.Metadata}
+		uopts.setHeaders(req.Header, o.key, nil)
+	}
+
+	resp, err := o.s3.send(req, 200, "error copying object")
+	if err != nil {
+		return preconditionError(err)
+	}
+	return decodeCopyResult(resp, "error copying object", &struct{}{})
+}
+
+// copiedHeaders are the headers of the source that are set on the
+// multipart upload of a copy. The storage class is kept even if the metadata
+// is replaced.
+var copiedHeaders = []string{
+	"Cache-Control",
+	"Content-Disposition",
+	"Content-Encoding",
+	"Content-Language",
+	"Expires",
+	"X-Amz-Storage-Class",
+}
+
+// copyMultipart copies src, which is larger than maxCopySize, with a
+// multipart upload of UploadPartCopy requests.
+//
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPartCopy.html
+func (o *object) copyMultipart(src Object, info *ObjectInfo, opts CopyOptions) error {
+	// the headers are not copied from the source by multipart uploads
+	h, err := src.Head()
+	if err != nil {
+		return err
+	}
+	uopts := UploadOptions{ContentType: info.ContentType, Metadata: info.Metadata}
+	if opts.ReplaceMetadata {
+		uopts = UploadOptions{ContentType: opts.ContentType, Metadata: opts.Metadata}
+	}
+	w := newWriter(context.Background(), o, uopts)
+	defer w.cancel()
+	w.header = make(http.Header)
+	for _, k := range copiedHeaders {
+		if v := http.Header(h).Get(k); v != "" && (!opts.ReplaceMetadata || k == "X-Amz-Storage-Class") {
+			w.header.Set(k, v)
+		}
+	}
+	if n, _ := strconv.Atoi(http.Header(h).Get("X-Amz-Tagging-Count")); n > 0 {
+		tags, err := src.Tags()
+		if err != nil {
+			return err
+		}
+		w.header.Set("X-Amz-Tagging", encodeTags(tags))
+	}
+	if err := w.prepare(nil); err != nil {
+		return err
+	}
+
+	partSize := copyPartSize
+	if n := partCount(info.Size, partSize); n > MaxNumParts {
+		partSize = (info.Size + MaxNumParts - 1) / MaxNumParts
+	}
+	for start, n := int64(0), 1; start < info.Size; start, n = start+partSize, n+1 {
+		end := start + partSize - 1
+		if end >= info.Size {
+			end = info.Size - 1
+		}
+		p, err := w.copyPart(src, &opts, n, start, end)
+		if err != nil {
+			w.abort()
+			return err
+		}
+		w.xml.Part = append(w.xml.Part, p)
+	}
+
+	if err := w.complete(); err != nil {
+		w.abort()
+		return err
+	}
+	return nil
+}
+
+// copyPart copies bytes start to end of src as part n
+func (w *writer) copyPart(src Object, opts *CopyOptions, n int, start, end int64) (*part, error) {
+	uv := make(url.Values)
+	uv.Set("partNumber", strconv.Itoa(n))
+	uv.Set("uploadId", w.uploadId)
+	req, err := w.o.newRequest(w.ctx, "PUT", `?`+uv.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Amz-Copy-Source", copySource(src))
+	req.Header.Set("X-Amz-Copy-Source-Range", fmt.Sprintf("bytes=%d-%d", start, end))
+	opts.setConditions(req.Header)
+
+	resp, err := w.o.s3.send(req, 200, "error copying part")
+	if err != nil {
+		return nil, preconditionError(err)
+	}
+	var result struct {
+		ETag string
+	}
+	if err := decodeCopyResult(resp, "error copying part", &result); err != nil {
+		return nil, err
+	}
+	return &part{PartNumber: n, ETag: result.ETag}, nil
+}
+
+// decodeCopyResult decodes the result of a copy request into v and closes
+// the body. A copy can fail after the 200 status was sent, in which case the
+// body contains an error document instead of the result.
+func decodeCopyResult(resp *http.Response, serr string, v interface{}) error {
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		XMLName xml.Name
+	}
+	if err := xml.Unmarshal(b, &result); err != nil {
+		return err
+	}
+	if result.XMLName.Local == "Error" {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+		return newS3Error(resp, "%s", serr)
+	}
+	return xml.Unmarshal(b, v)
+}
+
+// copySource returns the escaped x-amz-copy-source header value for src
+func copySource(src Object) string {
+	return `/` + escapePath(src.S3().Bucket+`/`+src.Key())
+}
@@ -0,0 +1,296 @@
+package s3
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCopyFrom(t *testing.T) {
+	var source string
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "HEAD" {
+			// the source size is checked before copying
+			if r.URL.Path == "/prefix/missing" {
+				w.WriteHeader(404)
+				return
+			}
+			w.Header().Set("Content-Length", "4")
+			w.Header().Set("Last-Modified", "Wed, 12 Oct 2009 17:50:00 GMT")
+			return
+		}
+		if r.Method != "PUT" {
+			t.Error(r.Method)
+		}
+		source = r.Header.Get("X-Amz-Copy-Source")
+		switch source {
+		case "/bucket/prefix/missing":
+			w.WriteHeader(404)
+			w.Write([]byte(`<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+		case "/bucket/prefix/failed":
+			w.Write([]byte(`<Error><Code>InternalError</Code><Message>We encountered an internal error.</Message></Error>`))
+		default:
+			w.Write([]byte(`<CopyObjectResult><LastModified>2009-10-12T17:50:30.000Z</LastModified><ETag>"9b2cf535f27731c974343645a3985328"</ETag></CopyObjectResult>`))
+		}
+	}))
+	s3.Path = "prefix"
+
+	dst := s3.Object("dst")
+
+	// spaces, slashes and unicode
+	if err := dst.CopyFrom("dir/my file ü.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if x := source; x != "/bucket/prefix/dir/my%20file%20%C3%BC.txt" {
+		t.Fatal(x)
+	}
+
+	// across buckets
+	other := *s3
+	other.Bucket = "other"
+	other.Path = ""
+	if err := dst.CopyFromObject(other.Object("/a b/c")); err != nil {
+		t.Fatal(err)
+	}
+	if x := source; x != "/other/a%20b/c" {
+		t.Fatal(x)
+	}
+
+	// missing source
+	err := dst.CopyFrom("missing")
+	if e, ok := err.(*S3Error); !ok || e.Code != "NoSuchKey" || e.StatusCode != 404 {
+		t.Fatal(err)
+	}
+
+	// error after 200
+	err = dst.CopyFrom("failed")
+	if e, ok := err.(*S3Error); !ok || e.Code != "InternalError" {
+		t.Fatal(err)
+	}
+}
+
+func TestCopyFromWithOptions(t *testing.T) {
+	s3, f := newFakeS3(t)
+	h := make(http.Header)
+	h.Set("Content-Type", "text/plain")
+	h.Set("X-Amz-Meta-Author", "me")
+	f.put("/src.txt", []byte("data"), h)
+	src := s3.Object("src.txt")
+
+	// copy
+	if err := s3.Object("copy.txt").CopyFromWithOptions(src, CopyOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	o := f.get("/copy.txt")
+	if string(o.body) != "data" || o.header.Get("Content-Type") != "text/plain" || o.header.Get("X-Amz-Meta-Author") != "me" {
+		t.Fatal(o.header)
+	}
+	if x := f.lastHeader().Get("X-Amz-Metadata-Directive"); x != "" {
+		t.Fatal(x)
+	}
+
+	// options are ignored by the COPY directive
+	err := s3.Object("copy.txt").CopyFromWithOptions(src, CopyOptions{ContentType: "text/csv"})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	// replace
+	err = s3.Object("replaced.csv").CopyFromWithOptions(src, CopyOptions{
+		ReplaceMetadata: true,
+		Metadata:        map[string]string{"Reviewer": "you"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := f.lastHeader().Get("X-Amz-Metadata-Directive"); x != "REPLACE" {
+		t.Fatal(x)
+	}
+	o = f.get("/replaced.csv")
+	if string(o.body) != "data" || o.header.Get("Content-Type") != "text/csv" {
+		t.Fatal(o.header)
+	}
+	if o.header.Get("X-Amz-Meta-Author") != "" || o.header.Get("X-Amz-Meta-Reviewer") != "you" {
+		t.Fatal(o.header)
+	}
+}
+
+func TestMoveTo(t *testing.T) {
+	f := newFakeServer()
+	failDelete, failRollback, lost := false, false, false
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			switch {
+			case failDelete && r.URL.Path == "/src":
+				if lost {
+					// deleted, but the response is an error
+					f.ServeHTTP(httptest.NewRecorder(), r)
+				}
+				f.error(w, 403, "AccessDenied")
+				return
+			case failRollback:
+				f.error(w, 500, "InternalError")
+				return
+			}
+		}
+		f.ServeHTTP(w, r)
+	}))
+	f.put("/src", []byte("data"), nil)
+
+	if err := s3.Object("src").MoveTo("dst"); err != nil {
+		t.Fatal(err)
+	}
+	if f.get("/src") != nil || string(f.get("/dst").body) != "data" {
+		t.Fatal("not moved")
+	}
+	if err := s3.Object("dst").MoveTo("dst"); err == nil {
+		t.Fatal("expected error")
+	}
+
+	// the copy is removed if the source can't be deleted
+	f.put("/src", []byte("data"), nil)
+	failDelete = true
+	if err := s3.Object("src").MoveTo("new"); err == nil {
+		t.Fatal("expected error")
+	}
+	if f.get("/src") == nil || f.get("/new") != nil {
+		t.Fatal("not rolled back")
+	}
+
+	// a failed rollback is reported with the original error
+	failRollback = true
+	err := s3.Object("src").MoveTo("new")
+	if err == nil || !strings.Contains(err.Error(), "AccessDenied") || !strings.Contains(err.Error(), "InternalError") {
+		t.Fatal(err)
+	}
+	failRollback = false
+
+	// the copy is kept if the source was deleted despite the error
+	lost = true
+	if err := s3.Object("src").MoveTo("new"); err != nil {
+		t.Fatal(err)
+	}
+	if f.get("/src") != nil || string(f.get("/new").body) != "data" {
+		t.Fatal("copy removed")
+	}
+}
+
+func TestCopyMultipart(t *testing.T) {
+	defer func(size, partSize int64) {
+		maxCopySize, copyPartSize = size, partSize
+	}(maxCopySize, copyPartSize)
+	maxCopySize, copyPartSize = 8, 4
+
+	var ranges []string
+	f := newFakeServer()
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get("X-Amz-Copy-Source-Range"); v != "" {
+			ranges = append(ranges, v)
+		}
+		f.ServeHTTP(w, r)
+	}))
+	h := make(http.Header)
+	h.Set("Content-Type", "text/plain")
+	h.Set("X-Amz-Meta-Author", "me")
+	f.put("/src", []byte("0123456789"), h)
+	f.put("/small", []byte("01234567"), nil)
+
+	if err := s3.Object("dst").CopyFrom("src"); err != nil {
+		t.Fatal(err)
+	}
+	if x := strings.Join(ranges, ","); x != "bytes=0-3,bytes=4-7,bytes=8-9" {
+		t.Fatal(x)
+	}
+	o := f.get("/dst")
+	if string(o.body) != "0123456789" || o.header.Get("Content-Type") != "text/plain" || o.header.Get("X-Amz-Meta-Author") != "me" {
+		t.Fatal(string(o.body), o.header)
+	}
+	if f.count("POST /dst?uploads") != 1 || f.count("PUT /dst?partNumber=") != 3 {
+		t.Fatal(f.requests)
+	}
+
+	// content headers and the storage class
+	h.Set("Cache-Control", "max-age=60")
+	h.Set("Content-Encoding", "gzip")
+	h.Set("Content-Language", "de")
+	h.Set("X-Amz-Storage-Class", "STANDARD_IA")
+	f.put("/src", []byte("0123456789"), h)
+	if err := s3.Object("dst").CopyFrom("src"); err != nil {
+		t.Fatal(err)
+	}
+	o = f.get("/dst")
+	for _, k := range []string{"Cache-Control", "Content-Encoding", "Content-Language", "X-Amz-Storage-Class"} {
+		if o.header.Get(k) != h.Get(k) {
+			t.Fatal(k, o.header)
+		}
+	}
+
+	// replaced metadata
+	err := s3.Object("dst").CopyFromWithOptions(s3.Object("src"), CopyOptions{
+		ReplaceMetadata: true,
+		ContentType:     "text/csv",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	o = f.get("/dst")
+	if o.header.Get("Content-Type") != "text/csv" || o.header.Get("X-Amz-Meta-Author") != "" ||
+		o.header.Get("Cache-Control") != "" || o.header.Get("X-Amz-Storage-Class") != "STANDARD_IA" {
+		t.Fatal(o.header)
+	}
+
+	// sources up to maxCopySize are copied in a single request
+	ranges = nil
+	if err := s3.Object("dst2").CopyFrom("small"); err != nil {
+		t.Fatal(err)
+	}
+	if len(ranges) != 0 || f.count("POST /dst2?uploads") != 0 || string(f.get("/dst2").body) != "01234567" {
+		t.Fatal(ranges, f.requests)
+	}
+}
+
+func TestCopyConditions(t *testing.T) {
+	var header http.Header
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "HEAD" {
+			w.Header().Set("Content-Length", "4")
+			w.Header().Set("Last-Modified", "Wed, 12 Oct 2009 17:50:00 GMT")
+			return
+		}
+		header = r.Header
+		if r.Header.Get("X-Amz-Copy-Source-If-Match") == `"stale"` {
+			w.WriteHeader(412)
+			w.Write([]byte(`<Error><Code>PreconditionFailed</Code><Message>At least one of the pre-conditions you specified did not hold</Message></Error>`))
+			return
+		}
+		w.Write([]byte(`<CopyObjectResult><ETag>"etag"</ETag></CopyObjectResult>`))
+	}))
+
+	since := time.Date(2015, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600))
+	err := s3.Object("dst").CopyFromWithOptions(s3.Object("src"), CopyOptions{
+		IfMatch:           "etag",
+		IfNoneMatch:       `"other"`,
+		IfModifiedSince:   since,
+		IfUnmodifiedSince: since.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range map[string]string{
+		"X-Amz-Copy-Source-If-Match":            `"etag"`,
+		"X-Amz-Copy-Source-If-None-Match":       `"other"`,
+		"X-Amz-Copy-Source-If-Modified-Since":   "Fri, 02 Jan 2015 02:04:05 GMT",
+		"X-Amz-Copy-Source-If-Unmodified-Since": "Fri, 02 Jan 2015 03:04:05 GMT",
+	} {
+		if x := header.Get(k); x != v {
+			t.Fatal(k, x)
+		}
+	}
+
+	err = s3.Object("dst").CopyFromWithOptions(s3.Object("src"), CopyOptions{IfMatch: "stale"})
+	if err != ErrPreconditionFailed {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,39 @@
+package s3
+
+import "encoding/xml"
+
+// CORSConfiguration holds the CORS rules of a bucket, which allow browsers
+// to access it from other origins, e.g. for uploads with FormUpload
+//
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketCors.html
+type CORSConfiguration struct {
+	XMLName xml.Name   `xml:"CORSConfiguration"`
+	Rules   []CORSRule `xml:"CORSRule"`
+}
+
+// CORSRule allows requests from AllowedOrigins with AllowedMethods, e.g.
+// "GET" or "POST", and AllowedHeaders. Origins and headers may contain one
+// "*" wildcard. Browsers cache the preflight response for MaxAgeSeconds.
+type CORSRule struct {
+	ID             string   `xml:",omitempty"`
+	AllowedOrigins []string `xml:"AllowedOrigin"`
+	AllowedMethods []string `xml:"AllowedMethod"`
+	AllowedHeaders []string `xml:"AllowedHeader,omitempty"`
+	ExposeHeaders  []string `xml:"ExposeHeader,omitempty"`
+	MaxAgeSeconds  int      `xml:",omitempty"`
+}
+
+// CORS returns the CORS configuration of the bucket. It has no rules if none
+// are configured.
+func (s3 *S3) CORS() (*CORSConfiguration, error) {
+	var cfg CORSConfiguration
+	if err := s3.getConfig("?cors", "NoSuchCORSConfiguration", &cfg, "error getting CORS configuration"); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// SetCORS replaces the CORS configuration of the bucket
+func (s3 *S3) SetCORS(cfg *CORSConfiguration) error {
+	return s3.putConfig("?cors", cfg, "error setting CORS configuration")
+}
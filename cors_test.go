@@ -0,0 +1,71 @@
+package s3
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCORS(t *testing.T) {
+	var stored []byte
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.URL.Query()["cors"]; !ok {
+			t.Error(r.URL)
+		}
+		switch r.Method {
+		case "PUT":
+			b, _ := ioutil.ReadAll(r.Body)
+			if r.Header.Get("Content-MD5") != contentMD5(b) {
+				w.WriteHeader(400)
+				w.Write([]byte(`<Error><Code>InvalidDigest</Code></Error>`))
+				return
+			}
+			stored = b
+		case "GET":
+			if stored == nil {
+				w.WriteHeader(404)
+				w.Write([]byte(`<Error><Code>NoSuchCORSConfiguration</Code></Error>`))
+				return
+			}
+			w.Write(stored)
+		}
+	}))
+
+	// not configured
+	cfg, err := s3.CORS()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Rules) != 0 {
+		t.Fatal(cfg.Rules)
+	}
+
+	in := &CORSConfiguration{Rules: []CORSRule{{
+		AllowedOrigins: []string{"https://example.com"},
+		AllowedMethods: []string{"GET", "POST"},
+		AllowedHeaders: []string{"*"},
+		MaxAgeSeconds:  3600,
+	}}}
+	if err := s3.SetCORS(in); err != nil {
+		t.Fatal(err)
+	}
+	expected := `<CORSConfiguration><CORSRule>` +
+		`<AllowedOrigin>https://example.com</AllowedOrigin>` +
+		`<AllowedMethod>GET</AllowedMethod><AllowedMethod>POST</AllowedMethod>` +
+		`<AllowedHeader>*</AllowedHeader>` +
+		`<MaxAgeSeconds>3600</MaxAgeSeconds>` +
+		`</CORSRule></CORSConfiguration>`
+	if x := string(stored); x != expected {
+		t.Fatal(x)
+	}
+
+	cfg, err = s3.CORS()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.XMLName = in.XMLName
+	if !reflect.DeepEqual(cfg, in) {
+		t.Fatal(cfg)
+	}
+}
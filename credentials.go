@@ -0,0 +1,106 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Credentials are AWS security credentials
+type Credentials struct {
+	AccessKey string
+	Secret    string
+
+	// Token is the session token of temporary credentials
+	Token string
+
+	// Expires is the expiration time of temporary credentials, or zero if
+	// they don't expire
+	Expires time.Time
+}
+
+// CredentialsProvider provides the credentials used to sign requests
+type CredentialsProvider interface {
+	// Retrieve returns the current credentials
+	Retrieve(ctx context.Context) (Credentials, error)
+}
+
+// StaticProvider provides fixed credentials
+type StaticProvider Credentials
+
+// Retrieve returns the credentials
+func (p StaticProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	return Credentials(p), nil
+}
+
+// credentialsExpiryWindow is how long before they expire cached credentials
+// are refreshed
+var credentialsExpiryWindow = 5 * time.Minute
+
+// CredentialsCache caches the credentials of a provider until shortly before
+// they expire. It is safe for concurrent use.
+type CredentialsCache struct {
+	provider CredentialsProvider
+
+	mu     sync.Mutex
+	creds  Credentials
+	cached bool
+}
+
+// NewCredentialsCache returns a cache of the credentials of p
+func NewCredentialsCache(p CredentialsProvider) *CredentialsCache {
+	return &CredentialsCache{provider: p}
+}
+
+// Retrieve returns the cached credentials, or retrieves them from the
+// provider if they are about to expire
+func (c *CredentialsCache) Retrieve(ctx context.Context) (Credentials, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cached && (c.creds.Expires.IsZero() || now().Add(credentialsExpiryWindow).Before(c.creds.Expires)) {
+		return c.creds, nil
+	}
+	creds, err := c.provider.Retrieve(ctx)
+	if err != nil {
+		return Credentials{}, err
+	}
+	c.creds, c.cached = creds, true
+	return creds, nil
+}
+
+// Expire makes the next Retrieve refresh the credentials
+func (c *CredentialsCache) Expire() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cached = false
+}
+
+// resolve returns s3 with the credentials of the configured provider. It
+// returns s3 itself if there is no provider.
+func (s3 *S3) resolve(ctx context.Context) (*S3, error) {
+	if s3.Credentials == nil {
+		return s3, nil
+	}
+	creds, err := s3.Credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("s3: error retrieving credentials: %w", err)
+	}
+	s3.clockSkew()
+	c := *s3
+	c.AccessKey, c.Secret, c.Token = creds.AccessKey, creds.Secret, creds.Token
+	return &c, nil
+}
+
+// resolve returns a copy of o with the credentials of the configured
+// provider
+func (o *object) resolve() (*object, error) {
+	s3, err := o.s3.resolve(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	c := *o
+	c.s3 = *s3
+	return &c, nil
+}
@@ -0,0 +1,93 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rotatingProvider returns new credentials, valid for an hour, on each call
+type rotatingProvider struct {
+	n int
+}
+
+func (p *rotatingProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	p.n++
+	return Credentials{
+		AccessKey: fmt.Sprintf("key%d", p.n),
+		Secret:    "secret",
+		Token:     fmt.Sprintf("token%d", p.n),
+		Expires:   now().Add(time.Hour),
+	}, nil
+}
+
+func TestCredentialsProvider(t *testing.T) {
+	defer func(f func() time.Time) { now = f }(now)
+	start := time.Now()
+	now = func() time.Time { return start }
+
+	s3, f := newFakeS3(t)
+	s3.AccessKey = "unused"
+	p := &rotatingProvider{}
+	s3.Credentials = NewCredentialsCache(p)
+
+	put := func() {
+		if err := s3.Object("key").Put(strings.NewReader("x"), 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	put()
+	put()
+	h := f.lastHeader()
+	if x := h.Get("Authorization"); !strings.HasPrefix(x, "AWS key1:") || h.Get("X-Amz-Security-Token") != "token1" {
+		t.Fatal(x)
+	}
+	if p.n != 1 {
+		t.Fatal(p.n)
+	}
+
+	// refreshed shortly before they expire
+	now = func() time.Time { return start.Add(56 * time.Minute) }
+	put()
+	h = f.lastHeader()
+	if x := h.Get("Authorization"); !strings.HasPrefix(x, "AWS key2:") || h.Get("X-Amz-Security-Token") != "token2" {
+		t.Fatal(x)
+	}
+
+	// presigned URLs use the provider too
+	u, err := s3.Object("key").ExpiringURL(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := u.Query().Get("AWSAccessKeyId"); x != "key2" {
+		t.Fatal(x)
+	}
+
+	// static credentials
+	s3.Credentials = StaticProvider{AccessKey: "static", Secret: "secret"}
+	put()
+	if x := f.lastHeader().Get("Authorization"); !strings.HasPrefix(x, "AWS static:") {
+		t.Fatal(x)
+	}
+}
+
+type failingProvider struct{}
+
+func (failingProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	return Credentials{}, errors.New("no credentials")
+}
+
+func TestCredentialsProviderError(t *testing.T) {
+	s3, f := newFakeS3(t)
+	s3.Credentials = failingProvider{}
+	_, err := s3.Object("key").Head()
+	if err == nil || !strings.Contains(err.Error(), "no credentials") {
+		t.Fatal(err)
+	}
+	if f.count("") != 0 {
+		t.Fatal("request was sent")
+	}
+}
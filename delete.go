@@ -0,0 +1,114 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+)
+
+// maxDeleteKeys is the maximum number of keys per multi-object delete request
+const maxDeleteKeys = 1000
+
+// DeleteResult is the result of deleting a single key with DeleteMultiple
+type DeleteResult struct {
+	// Key is the object key, relative to the configured Path
+	Key string
+
+	// Err is a *S3Error if the object could not be deleted
+	Err error
+}
+
+type deleteRequest struct {
+	XMLName xml.Name `xml:"Delete"`
+	Quiet   bool
+	Object  []deleteObject
+}
+
+type deleteObject struct {
+	Key string
+}
+
+type deleteResult struct {
+	Deleted []deleteObject
+	Error   []struct {
+		Key     string
+		Code    string
+		Message string
+	}
+}
+
+// DeleteMultiple deletes the objects with the specified keys, using as few
+// requests as possible. The result contains an entry for every key.
+//
+// http://docs.aws.amazon.com/AmazonS3/latest/API/multiobjectdeleteapi.html
+func (s3 *S3) DeleteMultiple(keys []string) ([]DeleteResult, error) {
+	return s3.deleteMultiple(keys, false)
+}
+
+// DeleteMultipleQuiet is like DeleteMultiple, but the result only contains
+// the keys that could not be deleted.
+func (s3 *S3) DeleteMultipleQuiet(keys []string) ([]DeleteResult, error) {
+	return s3.deleteMultiple(keys, true)
+}
+
+func (s3 *S3) deleteMultiple(keys []string, quiet bool) ([]DeleteResult, error) {
+	var results []DeleteResult
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > maxDeleteKeys {
+			n = maxDeleteKeys
+		}
+		r, err := s3.deleteChunk(keys[:n], quiet)
+		results = append(results, r...)
+		if err != nil {
+			return results, err
+		}
+		keys = keys[n:]
+	}
+	return results, nil
+}
+
+func (s3 *S3) deleteChunk(keys []string, quiet bool) ([]DeleteResult, error) {
+	d := deleteRequest{Quiet: quiet}
+	for _, k := range keys {
+		d.Object = append(d.Object, deleteObject{Key: s3.Object(k).Key()})
+	}
+	b, err := xml.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s3.newRequest(context.Background(), "POST", "?delete", bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-MD5", contentMD5(b))
+
+	resp, err := s3.send(req, 200, "error deleting objects")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result deleteResult
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	results := make([]DeleteResult, 0, len(result.Deleted)+len(result.Error))
+	for _, v := range result.Deleted {
+		results = append(results, DeleteResult{Key: s3.relativeKey(v.Key)})
+	}
+	for _, v := range result.Error {
+		results = append(results, DeleteResult{
+			Key: s3.relativeKey(v.Key),
+			Err: &S3Error{
+				StatusCode: resp.StatusCode,
+				Code:       v.Code,
+				Message:    v.Message,
+				text:       "error deleting " + v.Key,
+			},
+		})
+	}
+	return results, nil
+}
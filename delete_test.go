@@ -0,0 +1,104 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// deleteHandler fails to delete keys containing "locked"
+func deleteHandler(t *testing.T, chunks *[]int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.URL.Query()["delete"]; !ok || r.Method != "POST" {
+			t.Error(r.Method, r.URL)
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		sum := md5.Sum(b)
+		if x := r.Header.Get("Content-MD5"); x != base64.StdEncoding.EncodeToString(sum[:]) {
+			t.Error(x)
+		}
+
+		var d deleteRequest
+		if err := xml.Unmarshal(b, &d); err != nil {
+			t.Error(err)
+		}
+		*chunks = append(*chunks, len(d.Object))
+
+		fmt.Fprint(w, `<DeleteResult>`)
+		for _, o := range d.Object {
+			if strings.Contains(o.Key, "locked") {
+				fmt.Fprintf(w, `<Error><Key>%s</Key><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`, o.Key)
+			} else if !d.Quiet {
+				fmt.Fprintf(w, `<Deleted><Key>%s</Key></Deleted>`, o.Key)
+			}
+		}
+		fmt.Fprint(w, `</DeleteResult>`)
+	}
+}
+
+func TestDeleteMultiple(t *testing.T) {
+	var chunks []int
+	s3 := newTestS3(t, deleteHandler(t, &chunks))
+	s3.Path = "path"
+
+	keys := make([]string, 2001)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("key-%d", i)
+	}
+	keys[1000] = "locked-key"
+
+	results, err := s3.DeleteMultiple(keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := fmt.Sprint(chunks); x != "[1000 1000 1]" {
+		t.Fatal(x)
+	}
+	if x := len(results); x != len(keys) {
+		t.Fatal(x)
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.Err == nil {
+			continue
+		}
+		failed++
+		if r.Key != "locked-key" {
+			t.Fatal(r.Key)
+		}
+		if e := r.Err.(*S3Error); e.Code != "AccessDenied" {
+			t.Fatal(e)
+		}
+	}
+	if failed != 1 {
+		t.Fatal(failed)
+	}
+}
+
+func TestDeleteMultipleQuiet(t *testing.T) {
+	var chunks []int
+	s3 := newTestS3(t, deleteHandler(t, &chunks))
+
+	results, err := s3.DeleteMultipleQuiet([]string{"a", "locked", "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := len(results); x != 1 {
+		t.Fatal(x)
+	}
+	if x := results[0]; x.Key != "locked" || x.Err == nil {
+		t.Fatal(x)
+	}
+
+	// no keys, no requests
+	results, err = s3.DeleteMultiple(nil)
+	if err != nil || len(results) != 0 || len(chunks) != 1 {
+		t.Fatal(results, err, chunks)
+	}
+}
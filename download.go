@@ -0,0 +1,161 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// DownloadOptions holds optional settings for DownloadToFileWithOptions
+type DownloadOptions struct {
+	// Concurrency is the number of parallel range requests. If 0, the
+	// default upload concurrency is used.
+	Concurrency int
+
+	// PartSize is the size of the ranges. If 0, MinPartSize is used.
+	PartSize int64
+
+	// PinETag makes all range requests conditional on the ETag the object
+	// has when the download starts, so that ErrObjectChanged is returned if
+	// it is replaced during the download
+	PinETag bool
+
+	// IfMatch downloads the object only if its ETag matches. Otherwise
+	// ErrObjectChanged is returned. It takes precedence over PinETag.
+	IfMatch string
+}
+
+func (o *object) DownloadToFile(path string, concurrency int, partSize int64) error {
+	return o.DownloadToFileWithOptions(path, DownloadOptions{
+		Concurrency: concurrency,
+		PartSize:    partSize,
+		PinETag:     true,
+	})
+}
+
+func (o *object) DownloadToFileWithOptions(path string, opts DownloadOptions) (err error) {
+	concurrency, partSize := opts.Concurrency, opts.PartSize
+	if concurrency <= 0 {
+		concurrency = nConcurrentUploads
+	}
+	if partSize <= 0 {
+		partSize = MinPartSize
+	}
+
+	h, err := o.Head()
+	if err != nil {
+		return err
+	}
+	size, err := h.ContentLength()
+	if err != nil {
+		return err
+	}
+	// all ranges must be read from the same version of the object
+	etag := opts.IfMatch
+	if etag == "" && opts.PinETag {
+		etag = h.ETag()
+	}
+
+	// download to a temporary file in the same directory, which is renamed
+	// on success
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".part")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	if err = f.Truncate(size); err != nil {
+		return err
+	}
+	if err = o.download(f, etag, size, concurrency, partSize); err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// download fetches size bytes in ranges of partSize and writes them to w at
+// their offsets. If etag is not empty, ErrObjectChanged is returned if the
+// object is replaced meanwhile. The first error cancels the remaining
+// requests.
+func (o *object) download(w io.WriterAt, etag string, size int64, concurrency int, partSize int64) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	offsets := make(chan int64)
+	go func() {
+		defer close(offsets)
+		for off := int64(0); off < size; off += partSize {
+			select {
+			case offsets <- off:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	var firstErr error
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for off := range offsets {
+				end := off + partSize - 1
+				if end >= size {
+					end = size - 1
+				}
+				if err := o.downloadRange(ctx, w, etag, off, end); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// downloadRange writes the bytes start through end to w
+func (o *object) downloadRange(ctx context.Context, w io.WriterAt, etag string, start, end int64) error {
+	r, _, err := o.readerRange(ctx, etag, start, end)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	n, err := io.Copy(&offsetWriter{w: w, off: start}, r)
+	if err != nil {
+		return err
+	}
+	if n != end-start+1 {
+		return fmt.Errorf("s3: short read of range %d-%d: %d bytes", start, end, n)
+	}
+	return nil
+}
+
+// offsetWriter writes sequentially to an io.WriterAt, starting at off
+type offsetWriter struct {
+	w   io.WriterAt
+	off int64
+}
+
+func (w *offsetWriter) Write(p []byte) (int, error) {
+	n, err := w.w.WriteAt(p, w.off)
+	w.off += int64(n)
+	return n, err
+}
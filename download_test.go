@@ -0,0 +1,153 @@
+package s3
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDownloadToFile(t *testing.T) {
+	s3, f := newFakeS3(t)
+	data := bytes.Repeat([]byte("0123456789"), 100)
+	f.put("/key", data, nil)
+
+	path := filepath.Join(t.TempDir(), "file")
+	if err := s3.Object("key").DownloadToFile(path, 3, 300); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, data) {
+		t.Fatal(len(b))
+	}
+	if x := f.count("GET /key"); x != 4 {
+		t.Fatal(x)
+	}
+
+	// empty object
+	f.put("/empty", nil, nil)
+	path = filepath.Join(t.TempDir(), "empty")
+	if err := s3.Object("empty").DownloadToFile(path, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := ioutil.ReadFile(path); err != nil || len(b) != 0 {
+		t.Fatal(b, err)
+	}
+}
+
+func TestDownloadToFileError(t *testing.T) {
+	f := newFakeServer()
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.Header.Get("Range"), "bytes=600-") {
+			f.error(w, 500, "InternalError")
+			return
+		}
+		f.ServeHTTP(w, r)
+	}))
+	f.put("/key", bytes.Repeat([]byte("0123456789"), 100), nil)
+
+	dir := t.TempDir()
+	err := s3.Object("key").DownloadToFile(filepath.Join(dir, "file"), 2, 300)
+	if e, ok := err.(*S3Error); !ok || e.Code != "InternalError" {
+		t.Fatal(err)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Fatal(files[0].Name())
+	}
+}
+
+func TestDownloadToFileChanged(t *testing.T) {
+	f := newFakeServer()
+	replaced := false
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" && !replaced {
+			// replace the object between the Head and the range requests
+			replaced = true
+			f.put("/key", bytes.Repeat([]byte("b"), 1000), nil)
+		}
+		f.ServeHTTP(w, r)
+	}))
+	f.put("/key", bytes.Repeat([]byte("a"), 1000), nil)
+
+	path := filepath.Join(t.TempDir(), "file")
+	err := s3.Object("key").DownloadToFile(path, 1, 300)
+	if err != ErrObjectChanged {
+		t.Fatal(err)
+	}
+	if f.count("GET") != 1 {
+		t.Fatal(f.requests)
+	}
+	if _, err := ioutil.ReadFile(path); err == nil {
+		t.Fatal("file created")
+	}
+
+	// a restart succeeds
+	if err := s3.Object("key").DownloadToFile(path, 1, 300); err != nil {
+		t.Fatal(err)
+	}
+
+	etag := f.get("/key").header.Get("ETag")
+	r, _, err := s3.Object("key").ReaderRangeIfMatch(etag, 0, 9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Close()
+	if _, _, err := s3.Object("key").ReaderRangeIfMatch(md5ETag(nil), 0, 9); err != ErrObjectChanged {
+		t.Fatal(err)
+	}
+	if _, _, err := s3.Object("key").ReaderRange(2000, 3000); err == ErrObjectChanged || err == nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDownloadToFileWithOptions(t *testing.T) {
+	f := newFakeServer()
+	replaced := false
+	var ifMatch []string
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			ifMatch = append(ifMatch, r.Header.Get("If-Match"))
+			if !replaced {
+				replaced = true
+				f.put("/key", bytes.Repeat([]byte("b"), 1000), nil)
+			}
+		}
+		f.ServeHTTP(w, r)
+	}))
+	f.put("/key", bytes.Repeat([]byte("a"), 1000), nil)
+	path := filepath.Join(t.TempDir(), "file")
+
+	// without pinning, the replacement goes unnoticed
+	if err := s3.Object("key").DownloadToFileWithOptions(path, DownloadOptions{Concurrency: 1, PartSize: 300}); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := ioutil.ReadFile(path); err != nil || !bytes.Equal(b, bytes.Repeat([]byte("b"), 1000)) {
+		t.Fatal(string(b), err)
+	}
+	if len(ifMatch) != 4 || ifMatch[0] != "" {
+		t.Fatal(ifMatch)
+	}
+
+	// a given ETag
+	err := s3.Object("key").DownloadToFileWithOptions(path, DownloadOptions{IfMatch: md5ETag(nil)})
+	if err != ErrObjectChanged {
+		t.Fatal(err)
+	}
+	etag := f.get("/key").header.Get("ETag")
+	ifMatch = nil
+	if err := s3.Object("key").DownloadToFileWithOptions(path, DownloadOptions{IfMatch: etag, PartSize: 300}); err != nil {
+		t.Fatal(err)
+	}
+	if len(ifMatch) != 4 || strings.Trim(ifMatch[0], `"`) != strings.Trim(etag, `"`) {
+		t.Fatal(ifMatch)
+	}
+}
@@ -0,0 +1,148 @@
+package s3
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+func (o *object) ReaderDecoded() (io.ReadCloser, http.Header, error) {
+	req, err := o.newRequest(context.Background(), "GET", "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	// setting Accept-Encoding stops the transport from decompressing the
+	// body itself and dropping the Content-Encoding header
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	resp, err := o.s3.send(req, 200, "error creating reader")
+	if err != nil {
+		return nil, nil, err
+	}
+	r, h := resp.Body, resp.Header
+	enc := strings.ToLower(strings.TrimSpace(h.Get("Content-Encoding")))
+	decode := decoder(enc)
+	if decode == nil {
+		return r, h, nil
+	}
+	dr, err := decode(r)
+	if err != nil {
+		r.Close()
+		return nil, nil, err
+	}
+	return &decodedReader{r: dr, closers: []io.Closer{dr, r}}, h, nil
+}
+
+// DecoderFunc returns a reader of the decompressed data of r
+type DecoderFunc func(r io.Reader) (io.ReadCloser, error)
+
+var (
+	decodersMu sync.RWMutex
+
+	// decoders are the decoders of ReaderDecoded by content encoding
+	decoders = map[string]DecoderFunc{
+		"gzip": func(r io.Reader) (io.ReadCloser, error) {
+			return gzip.NewReader(r)
+		},
+		"zstd": func(r io.Reader) (io.ReadCloser, error) {
+			return newZstdReader(r), nil
+		},
+		"br": func(r io.Reader) (io.ReadCloser, error) {
+			return newBrotliReader(r), nil
+		},
+	}
+)
+
+// RegisterDecoder makes ReaderDecoded decompress objects with the content
+// encoding with fn. gzip, zstd and br are built in; registering one of them
+// replaces the built-in decoder, e.g. with a faster one.
+func RegisterDecoder(encoding string, fn DecoderFunc) {
+	decodersMu.Lock()
+	defer decodersMu.Unlock()
+	decoders[strings.ToLower(encoding)] = fn
+}
+
+// decoder returns the decoder of the content encoding, or nil
+func decoder(encoding string) DecoderFunc {
+	if encoding == "x-gzip" {
+		encoding = "gzip"
+	}
+	decodersMu.RLock()
+	defer decodersMu.RUnlock()
+	return decoders[encoding]
+}
+
+// decodedReader reads decoded data and closes the decoder and the body
+type decodedReader struct {
+	r       io.Reader
+	closers []io.Closer
+}
+
+func (r *decodedReader) Read(p []byte) (int, error) {
+	return r.r.Read(p)
+}
+
+func (r *decodedReader) Close() error {
+	var err error
+	for _, c := range r.closers {
+		if cerr := c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// gzipWriter compresses the data written to an upload
+type gzipWriter struct {
+	gz      *gzip.Writer
+	w       *writer
+	started bool
+}
+
+func (w *gzipWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		// the content type is detected from the uncompressed data
+		w.started = true
+		if w.w.opts.ContentType == "" {
+			w.w.opts.ContentType = w.w.opts.detectContentType(w.w.o.key, p)
+		}
+	}
+	return w.gz.Write(p)
+}
+
+// Flush flushes the compressor before the buffered data is uploaded
+func (w *gzipWriter) Flush() error {
+	if err := w.gz.Flush(); err != nil {
+		return err
+	}
+	return w.w.Flush()
+}
+
+func (w *gzipWriter) Close() error {
+	if err := w.gz.Close(); err != nil {
+		w.w.close(true)
+		return err
+	}
+	return w.w.close(false)
+}
+
+func (w *gzipWriter) Abort() error {
+	return w.w.close(true)
+}
+
+// gzipBytes returns b compressed with gzip
+func gzipBytes(b []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(b); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,148 @@
+package s3
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestReaderDecoded(t *testing.T) {
+	s3, f := newFakeS3(t)
+
+	var b bytes.Buffer
+	zw := gzip.NewWriter(&b)
+	zw.Write([]byte("plain text"))
+	zw.Close()
+	h := make(http.Header)
+	h.Set("Content-Encoding", "gzip")
+	f.put("/compressed", b.Bytes(), h)
+	f.put("/plain", []byte("plain text"), nil)
+	f.put("/corrupt", []byte("not gzip"), h)
+	h = make(http.Header)
+	h.Set("Content-Encoding", "zstd")
+	f.put("/zstd", unbase64(t, zstdLines), h)
+	h = make(http.Header)
+	h.Set("Content-Encoding", "br")
+	f.put("/br", unbase64(t, brotliLines), h)
+
+	for key, want := range map[string]string{
+		"compressed": "plain text",
+		"plain":      "plain text",
+		"zstd":       testLines(),
+		"br":         testLines(),
+	} {
+		r, _, err := s3.Object(key).ReaderDecoded()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadAll(r)
+		if err != nil || string(data) != want {
+			t.Fatal(key, string(data), err)
+		}
+		if err := r.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, _, err := s3.Object("corrupt").ReaderDecoded(); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestRegisterDecoder(t *testing.T) {
+	s3, f := newFakeS3(t)
+
+	var b bytes.Buffer
+	zw := zlib.NewWriter(&b)
+	zw.Write([]byte("plain text"))
+	zw.Close()
+	h := make(http.Header)
+	h.Set("Content-Encoding", "deflate")
+	f.put("/deflate", b.Bytes(), h)
+	h = make(http.Header)
+	h.Set("Content-Encoding", "compress")
+	f.put("/compress", []byte("compressed data"), h)
+
+	read := func(key string) string {
+		r, _, err := s3.Object(key).ReaderDecoded()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Close()
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+
+	// unknown encodings are returned unchanged
+	if x := read("deflate"); x != b.String() {
+		t.Fatal(x)
+	}
+	if x := read("compress"); x != "compressed data" {
+		t.Fatal(x)
+	}
+
+	RegisterDecoder("Deflate", func(r io.Reader) (io.ReadCloser, error) {
+		return zlib.NewReader(r)
+	})
+	defer func() {
+		decodersMu.Lock()
+		delete(decoders, "deflate")
+		decodersMu.Unlock()
+	}()
+	if x := read("deflate"); x != "plain text" {
+		t.Fatal(x)
+	}
+}
+
+func TestUploadGzip(t *testing.T) {
+	s3, f := newFakeS3(t)
+
+	// random data doesn't compress, so the upload has several parts
+	data := make([]byte, 2*MinPartSize+100)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	w := s3.Object("data").WriterWithOptions(UploadOptions{Gzip: true, SinglePutThreshold: -1})
+	for i := 0; i < len(data); i += 1000 {
+		end := i + 1000
+		if end > len(data) {
+			end = len(data)
+		}
+		if _, err := w.Write(data[i:end]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if v := f.get("/data").header.Get("Content-Encoding"); v != "gzip" {
+		t.Fatal(v)
+	}
+
+	if err := s3.Object("index").Put(strings.NewReader("<html></html>"), -1, WithUploadOptions(UploadOptions{Gzip: true})); err != nil {
+		t.Fatal(err)
+	}
+	if v := f.get("/index").header.Get("Content-Type"); v != "text/html; charset=utf-8" {
+		t.Fatal(v)
+	}
+
+	for key, want := range map[string][]byte{"data": data, "index": []byte("<html></html>")} {
+		r, _, err := s3.Object(key).ReaderDecoded()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil || !bytes.Equal(got, want) {
+			t.Fatal(key, len(got), err)
+		}
+	}
+}
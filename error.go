@@ -0,0 +1,89 @@
+package s3
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrNotModified is returned by conditional reads if the object is unchanged
+var ErrNotModified = errors.New("s3: not modified")
+
+// ErrNotFound is returned by Stat if the object does not exist
+var ErrNotFound = errors.New("s3: not found")
+
+// ErrPreconditionFailed is returned by conditional copies, deletes and
+// uploads if the condition is not met
+var ErrPreconditionFailed = errors.New("s3: precondition failed")
+
+// ErrObjectChanged is returned by reads pinned to an ETag if the object was
+// replaced
+var ErrObjectChanged = errors.New("s3: object changed")
+
+// S3Error is returned if S3 responds with an unexpected status code. The
+// fields are parsed from the XML error document in the response body, if
+// there is one.
+//
+// http://docs.aws.amazon.com/AmazonS3/latest/API/ErrorResponses.html
+type S3Error struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int `xml:"-"`
+
+	// Code is the S3 error code, e.g. "NoSuchKey" or "AccessDenied"
+	Code string
+
+	// Message is the human readable error description
+	Message string
+
+	RequestId string
+	HostId    string
+
+	// StringToSign and CanonicalRequest are the strings S3 computed for a
+	// SignatureDoesNotMatch error. Compare them with the string passed to
+	// S3.DebugSignature.
+	StringToSign     string
+	CanonicalRequest string
+
+	text string
+}
+
+func newS3Error(resp *http.Response, strFmt string, args ...interface{}) *S3Error {
+	e := &S3Error{
+		text: fmt.Sprintf(strFmt, args...),
+	}
+	if resp == nil {
+		return e
+	}
+	e.StatusCode = resp.StatusCode
+
+	var b bytes.Buffer
+	b.ReadFrom(resp.Body)
+
+	// HEAD responses and some errors have no body, ignore parse errors
+	xml.Unmarshal(b.Bytes(), e)
+
+	if e.RequestId == "" {
+		e.RequestId = resp.Header.Get("X-Amz-Request-Id")
+	}
+	if e.HostId == "" {
+		e.HostId = resp.Header.Get("X-Amz-Id-2")
+	}
+	return e
+}
+
+func (e *S3Error) Error() string {
+	if e.Code == "" {
+		return "s3: " + e.text
+	}
+	return "s3: " + e.text + ": " + e.Code + ": " + e.Message
+}
+
+// preconditionError maps a failed precondition to ErrPreconditionFailed
+func preconditionError(err error) error {
+	if e, ok := err.(*S3Error); ok && e.StatusCode == 412 {
+		return ErrPreconditionFailed
+	}
+	return err
+}
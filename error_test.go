@@ -0,0 +1,91 @@
+package s3
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestS3Error(t *testing.T) {
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Amz-Request-Id", "header-request-id")
+		w.WriteHeader(404)
+		if r.Method == "GET" {
+			w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<Error>
+  <Code>NoSuchKey</Code>
+  <Message>The resource you requested does not exist</Message>
+  <Resource>/bucket/key</Resource>
+  <RequestId>4442587FB7D0A2F9</RequestId>
+  <HostId>host-id</HostId>
+</Error>`))
+		}
+	}))
+
+	_, _, err := s3.Object("key").Reader()
+	e, ok := err.(*S3Error)
+	if !ok {
+		t.Fatal(err)
+	}
+	if x := e.StatusCode; x != 404 {
+		t.Fatal(x)
+	}
+	if x := e.Code; x != "NoSuchKey" {
+		t.Fatal(x)
+	}
+	if x := e.Message; x != "The resource you requested does not exist" {
+		t.Fatal(x)
+	}
+	if x := e.RequestId; x != "4442587FB7D0A2F9" {
+		t.Fatal(x)
+	}
+	if x := e.HostId; x != "host-id" {
+		t.Fatal(x)
+	}
+	if x := e.Error(); x != "s3: error creating reader (Not Found): NoSuchKey: The resource you requested does not exist" {
+		t.Fatal(x)
+	}
+
+	// no body
+	_, err = s3.Object("key").Head()
+	e, ok = err.(*S3Error)
+	if !ok {
+		t.Fatal(err)
+	}
+	if x := e.StatusCode; x != 404 {
+		t.Fatal(x)
+	}
+	if x := e.Code; x != "" {
+		t.Fatal(x)
+	}
+	if x := e.RequestId; x != "header-request-id" {
+		t.Fatal(x)
+	}
+	if x := e.Error(); x != "s3: error getting head (Not Found)" {
+		t.Fatal(x)
+	}
+}
+
+func TestSignatureDoesNotMatch(t *testing.T) {
+	var local string
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(403)
+		w.Write([]byte(`<Error><Code>SignatureDoesNotMatch</Code><Message>The request signature we calculated does not match the signature you provided.</Message>` +
+			`<StringToSign>GET\n\n\nremote\n/bucket/key</StringToSign><CanonicalRequest>GET /key</CanonicalRequest></Error>`))
+	}))
+	s3.DebugSignature = func(req *http.Request, stringToSign string) {
+		local = stringToSign
+	}
+
+	_, _, err := s3.Object("key").Reader()
+	e, ok := err.(*S3Error)
+	if !ok || e.Code != "SignatureDoesNotMatch" {
+		t.Fatal(err)
+	}
+	if e.StringToSign != `GET\n\n\nremote\n/bucket/key` || e.CanonicalRequest != "GET /key" {
+		t.Fatal(e.StringToSign, e.CanonicalRequest)
+	}
+	if !strings.HasPrefix(local, "GET\n\n\n") || !strings.HasSuffix(local, "\n/bucket/key") {
+		t.Fatal(local)
+	}
+}
@@ -0,0 +1,111 @@
+package s3
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// maxEventMessageSize limits the size of a single event stream message
+const maxEventMessageSize = 16 << 20
+
+// errEventStreamCRC is returned if an event stream message is corrupt
+var errEventStreamCRC = errors.New("s3: event stream checksum mismatch")
+
+// errEventStreamHeaders is returned if the headers of a message can't be
+// parsed
+var errEventStreamHeaders = errors.New("s3: invalid event stream headers")
+
+// eventMessage is a message of an event stream
+type eventMessage struct {
+	headers map[string]string
+	payload []byte
+}
+
+// readEventMessage reads the next message of an AWS event stream. Each
+// message consists of a prelude with the total and headers length and their
+// CRC, the headers, the payload and a CRC of the whole message. Only string
+// header values are returned, others are skipped.
+//
+// https://docs.aws.amazon.com/AmazonS3/latest/API/RESTSelectObjectAppendix.html
+func readEventMessage(r io.Reader) (*eventMessage, error) {
+	var prelude [12]byte
+	if _, err := io.ReadFull(r, prelude[:]); err != nil {
+		return nil, err
+	}
+	total := binary.BigEndian.Uint32(prelude[0:4])
+	hlen := binary.BigEndian.Uint32(prelude[4:8])
+	if crc32.ChecksumIEEE(prelude[:8]) != binary.BigEndian.Uint32(prelude[8:12]) {
+		return nil, errEventStreamCRC
+	}
+	if total > maxEventMessageSize || total < 16 || hlen > total-16 {
+		return nil, fmt.Errorf("s3: invalid event stream message length %d", total)
+	}
+
+	b := make([]byte, total)
+	copy(b, prelude[:])
+	if _, err := io.ReadFull(r, b[12:]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	if crc32.ChecksumIEEE(b[:total-4]) != binary.BigEndian.Uint32(b[total-4:]) {
+		return nil, errEventStreamCRC
+	}
+
+	headers, err := parseEventHeaders(b[12 : 12+hlen])
+	if err != nil {
+		return nil, err
+	}
+	return &eventMessage{headers: headers, payload: b[12+hlen : total-4]}, nil
+}
+
+// sizes of the non-string header value types, indexed by type
+var eventHeaderSizes = map[byte]int{
+	0: 0,  // true
+	1: 0,  // false
+	2: 1,  // byte
+	3: 2,  // short
+	4: 4,  // integer
+	5: 8,  // long
+	8: 8,  // timestamp
+	9: 16, // uuid
+}
+
+func parseEventHeaders(b []byte) (map[string]string, error) {
+	headers := make(map[string]string)
+	for len(b) > 0 {
+		n := int(b[0])
+		if len(b) < 1+n+1 {
+			return nil, errEventStreamHeaders
+		}
+		name := string(b[1 : 1+n])
+		typ := b[1+n]
+		b = b[2+n:]
+
+		switch typ {
+		case 6, 7: // byte array, string
+			if len(b) < 2 {
+				return nil, errEventStreamHeaders
+			}
+			l := int(binary.BigEndian.Uint16(b))
+			if len(b) < 2+l {
+				return nil, errEventStreamHeaders
+			}
+			if typ == 7 {
+				headers[name] = string(b[2 : 2+l])
+			}
+			b = b[2+l:]
+		default:
+			size, ok := eventHeaderSizes[typ]
+			if !ok || len(b) < size {
+				return nil, errEventStreamHeaders
+			}
+			b = b[size:]
+		}
+	}
+	return headers, nil
+}
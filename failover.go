@@ -0,0 +1,73 @@
+package s3
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Replica is a copy of the bucket that reads fail over to. Empty fields
+// default to those of the primary S3 configuration, except for Endpoint,
+// which defaults to the AWS endpoint of Region.
+type Replica struct {
+	Bucket   string
+	Region   string
+	Endpoint string
+}
+
+// failover reports if the read req failed and should be sent to a replica
+func failover(req *http.Request, resp *http.Response, err error) bool {
+	if req.Method != "GET" && req.Method != "HEAD" {
+		return false
+	}
+	if err != nil {
+		return req.Context().Err() == nil
+	}
+	return resp.StatusCode >= 500
+}
+
+// replica returns the configuration of replica r
+func (s3 *S3) replica(r Replica) *S3 {
+	s3.clockSkew()
+	c := *s3
+	c.Replicas = nil
+	if r.Bucket != "" {
+		c.Bucket = r.Bucket
+	}
+	if r.Region != "" {
+		c.Region = r.Region
+	}
+	c.Endpoint = r.Endpoint
+	return &c
+}
+
+// doReplicas sends req, whose result from the primary was resp and err, to
+// the replicas until one doesn't fail. The last failure is returned if all
+// fail.
+func (s3 *S3) doReplicas(req *http.Request, resp *http.Response, err error) (*http.Response, error) {
+	base := s3.bucketURL()
+	u := req.URL.String()
+	if !strings.HasPrefix(u, base) {
+		return resp, err
+	}
+
+	for _, r := range s3.Replicas {
+		c := s3.replica(r)
+		ru, perr := url.Parse(c.bucketURL() + u[len(base):])
+		if perr != nil {
+			continue
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		rreq := req.Clone(req.Context())
+		rreq.URL = ru
+		rreq.Host = ru.Host
+		resp, err = c.doRetry(rreq)
+		if !failover(rreq, resp, err) {
+			break
+		}
+	}
+	return resp, err
+}
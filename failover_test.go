@@ -0,0 +1,63 @@
+package s3
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestFailover(t *testing.T) {
+	f := newFakeServer()
+	f.put("/key", []byte("replica"), nil)
+	var hosts []string
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hosts = append(hosts, r.Host)
+		switch {
+		case strings.HasPrefix(r.Host, "bucket."):
+			f.error(w, 503, "SlowDown")
+		case strings.HasPrefix(r.Host, "down."):
+			f.error(w, 500, "InternalError")
+		default:
+			f.ServeHTTP(w, r)
+		}
+	}))
+	s3.Region = "eu-west-1"
+	s3.Replicas = []Replica{
+		{Bucket: "down", Region: "us-east-2"},
+		{Bucket: "replica", Region: "us-west-2"},
+	}
+
+	r, _, err := s3.Object("key").Reader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadAll(r)
+	r.Close()
+	if string(b) != "replica" {
+		t.Fatal(string(b))
+	}
+	expected := "bucket.s3.eu-west-1.amazonaws.com down.s3.us-east-2.amazonaws.com replica.s3.us-west-2.amazonaws.com"
+	if x := strings.Join(hosts, " "); x != expected {
+		t.Fatal(x)
+	}
+
+	// writes don't fail over
+	hosts = nil
+	if err := s3.Object("key").Put(strings.NewReader("x"), 1); err == nil {
+		t.Fatal("expected error")
+	}
+	if len(hosts) != 1 {
+		t.Fatal(hosts)
+	}
+
+	// client errors don't fail over
+	s3.Region = "us-west-2"
+	s3.Bucket = "replica"
+	hosts = nil
+	_, err = s3.Object("missing").Stat()
+	if !errors.Is(err, ErrNotFound) || len(hosts) != 1 {
+		t.Fatal(err, hosts)
+	}
+}
@@ -0,0 +1,97 @@
+package s3
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// PostForm holds the action URL and the fields of an HTML form for
+// browser-based uploads. The fields must be sent as hidden inputs before the
+// "file" input of a multipart/form-data POST request to Action.
+//
+// http://docs.aws.amazon.com/AmazonS3/latest/dev/HTTPPOSTForms.html
+type PostForm struct {
+	Action string
+	Fields map[string]string
+}
+
+func (o *object) FormUpload(acl ACL, policy Policy) (*PostForm, error) {
+	if err := o.s3.checkConfig(); err != nil {
+		return nil, err
+	}
+	o, err := o.resolve()
+	if err != nil {
+		return nil, err
+	}
+	if o.s3.signV4() {
+		return o.formUploadV4(acl, policy, o.s3.now())
+	}
+
+	b, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	policy64 := base64.StdEncoding.EncodeToString(b)
+	mac := hmac.New(sha1.New, []byte(o.s3.Secret))
+	mac.Write([]byte(policy64))
+
+	fields := map[string]string{
+		"AWSAccessKeyId": o.s3.AccessKey,
+		"acl":            string(acl),
+		"key":            o.Key(),
+		"policy":         policy64,
+		"signature":      base64.StdEncoding.EncodeToString(mac.Sum(nil)),
+	}
+	if o.s3.Token != "" {
+		fields["x-amz-security-token"] = o.s3.Token
+	}
+
+	return &PostForm{Action: o.s3.publicURL(), Fields: fields}, nil
+}
+
+// formUploadV4 returns a form signed with signature version 4 at t. The
+// x-amz-* fields are added as conditions to a copy of the policy.
+//
+// http://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-post-example.html
+func (o *object) formUploadV4(acl ACL, policy Policy, t time.Time) (*PostForm, error) {
+	t = t.UTC()
+	fields := map[string]string{
+		"acl":              string(acl),
+		"key":              o.Key(),
+		"x-amz-algorithm":  v4Algorithm,
+		"x-amz-credential": o.s3.AccessKey + `/` + o.s3.scopeV4(t),
+		"x-amz-date":       t.Format(v4TimeFormat),
+	}
+	if o.s3.Token != "" {
+		fields["x-amz-security-token"] = o.s3.Token
+	}
+
+	p := make(Policy, len(policy))
+	for k, v := range policy {
+		p[k] = v
+	}
+	var c PolicyConditions
+	if v, ok := policy["conditions"].(*PolicyConditions); ok {
+		c = append(c, *v...)
+	}
+	for _, k := range sortedKeys(fields) {
+		if strings.HasPrefix(k, "x-amz-") {
+			c.addKv(k, fields[k])
+		}
+	}
+	p["conditions"] = &c
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	fields["policy"] = base64.StdEncoding.EncodeToString(b)
+	fields["x-amz-signature"] = o.s3.signatureV4(t, fields["policy"])
+
+	return &PostForm{Action: o.s3.publicURL(), Fields: fields}, nil
+}
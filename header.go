@@ -3,17 +3,20 @@ package s3
 import (
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 )
 
+const metaPrefix = "X-Amz-Meta-"
+
 type Header http.Header
 
 func (h Header) Date() (time.Time, error) {
-	return time.Parse(time.RFC1123, http.Header(h).Get("Date"))
+	return http.ParseTime(http.Header(h).Get("Date"))
 }
 
 func (h Header) LastModified() (time.Time, error) {
-	return time.Parse(time.RFC1123, http.Header(h).Get("Last-Modified"))
+	return http.ParseTime(http.Header(h).Get("Last-Modified"))
 }
 
 func (h Header) ETag() string {
@@ -27,3 +30,46 @@ func (h Header) ContentLength() (int64, error) {
 func (h Header) ContentType() string {
 	return http.Header(h).Get("Content-Type")
 }
+
+func (h Header) ContentRange() string {
+	return http.Header(h).Get("Content-Range")
+}
+
+// VersionId returns the version of the object in a versioned bucket
+func (h Header) VersionId() string {
+	return http.Header(h).Get("X-Amz-Version-Id")
+}
+
+// Restore returns the restore status of an archived object, e.g.
+// `ongoing-request="true"`, or "" if no restore was requested
+func (h Header) Restore() string {
+	return http.Header(h).Get("X-Amz-Restore")
+}
+
+// ServerSideEncryption returns the server-side encryption algorithm of the
+// object, if any
+func (h Header) ServerSideEncryption() Encryption {
+	return Encryption(http.Header(h).Get("X-Amz-Server-Side-Encryption"))
+}
+
+// Metadata returns the user metadata of the object, stored in the
+// x-amz-meta-* headers. Keys are lower case and without the prefix.
+func (h Header) Metadata() map[string]string {
+	m := make(map[string]string)
+	for k, vv := range h {
+		k = http.CanonicalHeaderKey(k)
+		if strings.HasPrefix(k, metaPrefix) && len(vv) > 0 {
+			m[strings.ToLower(k[len(metaPrefix):])] = vv[0]
+		}
+	}
+	return m
+}
+
+// StorageClass returns the storage class of the object. S3 omits the header
+// for StorageStandard objects, in which case StorageStandard is returned.
+func (h Header) StorageClass() StorageClass {
+	if v := http.Header(h).Get("X-Amz-Storage-Class"); v != "" {
+		return StorageClass(v)
+	}
+	return StorageStandard
+}
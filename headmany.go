@@ -0,0 +1,73 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HeadResult is the result of HeadMany for a key. Err is ErrNotFound if the
+// object doesn't exist.
+type HeadResult struct {
+	Size         int64
+	ETag         string
+	LastModified time.Time
+	Header       Header
+	Err          error
+}
+
+// HeadMany does HEAD requests for the keys, up to concurrency at a time, and
+// returns the results by key
+func (s3 *S3) HeadMany(keys []string, concurrency int) map[string]HeadResult {
+	return s3.HeadManyContext(context.Background(), keys, concurrency)
+}
+
+// HeadManyContext is like HeadMany, but the requests are bound to ctx. Once
+// ctx is done, the remaining keys fail with its error.
+func (s3 *S3) HeadManyContext(ctx context.Context, keys []string, concurrency int) map[string]HeadResult {
+	if concurrency <= 0 {
+		concurrency = nConcurrentUploads
+	}
+
+	var mu sync.Mutex
+	results := make(map[string]HeadResult, len(keys))
+	kc := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range kc {
+				r := s3.headResult(ctx, key)
+				mu.Lock()
+				results[key] = r
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, key := range keys {
+		kc <- key
+	}
+	close(kc)
+	wg.Wait()
+	return results
+}
+
+// headResult does a HEAD request for key
+func (s3 *S3) headResult(ctx context.Context, key string) HeadResult {
+	if err := ctx.Err(); err != nil {
+		return HeadResult{Err: err}
+	}
+	h, err := s3.Object(key).(*object).statHead(ctx)
+	if err != nil {
+		return HeadResult{Err: err}
+	}
+	r := HeadResult{ETag: strings.Trim(h.ETag(), `"`), Header: h}
+	if r.Size, err = h.ContentLength(); err != nil {
+		r.Err = fmt.Errorf("s3: invalid Content-Length: %v", err)
+	}
+	r.LastModified, _ = h.LastModified()
+	return r
+}
@@ -0,0 +1,38 @@
+package s3
+
+import (
+	"context"
+	"testing"
+)
+
+func TestHeadMany(t *testing.T) {
+	s3, f := newFakeS3(t)
+	f.put("/a", []byte("aa"), nil)
+	f.put("/b", []byte("bbb"), nil)
+
+	results := s3.HeadMany([]string{"a", "b", "missing"}, 2)
+	if len(results) != 3 {
+		t.Fatal(results)
+	}
+	if r := results["a"]; r.Err != nil || r.Size != 2 || r.ETag != md5ETag([]byte("aa")) || r.LastModified.IsZero() {
+		t.Fatal(r)
+	}
+	if r := results["b"]; r.Err != nil || r.Size != 3 {
+		t.Fatal(r)
+	}
+	if r := results["missing"]; r.Err != ErrNotFound {
+		t.Fatal(r)
+	}
+
+	// canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	n := f.count("HEAD ")
+	results = s3.HeadManyContext(ctx, []string{"a", "b"}, 1)
+	if r := results["a"]; r.Err != context.Canceled {
+		t.Fatal(r)
+	}
+	if f.count("HEAD ") != n {
+		t.Fatal("requests were sent")
+	}
+}
@@ -0,0 +1,59 @@
+package s3
+
+import "encoding/xml"
+
+// LifecycleConfiguration holds the lifecycle rules of a bucket
+//
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketLifecycleConfiguration.html
+type LifecycleConfiguration struct {
+	XMLName xml.Name        `xml:"LifecycleConfiguration"`
+	Rules   []LifecycleRule `xml:"Rule"`
+}
+
+// LifecycleRule applies to the objects with keys starting with Prefix
+type LifecycleRule struct {
+	ID     string `xml:",omitempty"`
+	Prefix string
+
+	// Status is "Enabled" or "Disabled". If empty, SetLifecycle enables the
+	// rule.
+	Status string
+
+	Transitions []LifecycleTransition `xml:"Transition,omitempty"`
+	Expiration  *LifecycleExpiration  `xml:",omitempty"`
+}
+
+// LifecycleExpiration deletes objects Days after their creation
+type LifecycleExpiration struct {
+	Days int
+}
+
+// LifecycleTransition moves objects to StorageClass Days after their
+// creation
+type LifecycleTransition struct {
+	Days         int
+	StorageClass StorageClass
+}
+
+// Lifecycle returns the lifecycle configuration of the bucket. It has no
+// rules if none are configured.
+func (s3 *S3) Lifecycle() (*LifecycleConfiguration, error) {
+	var cfg LifecycleConfiguration
+	if err := s3.getConfig("?lifecycle", "NoSuchLifecycleConfiguration", &cfg, "error getting lifecycle configuration"); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// SetLifecycle replaces the lifecycle configuration of the bucket
+func (s3 *S3) SetLifecycle(cfg *LifecycleConfiguration) error {
+	c := *cfg
+	c.Rules = make([]LifecycleRule, len(cfg.Rules))
+	for i, r := range cfg.Rules {
+		if r.Status == "" {
+			r.Status = "Enabled"
+		}
+		c.Rules[i] = r
+	}
+	return s3.putConfig("?lifecycle", &c, "error setting lifecycle configuration")
+}
@@ -0,0 +1,76 @@
+package s3
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestLifecycle(t *testing.T) {
+	var stored []byte
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.URL.Query()["lifecycle"]; !ok {
+			t.Error(r.URL)
+		}
+		switch r.Method {
+		case "PUT":
+			b, _ := ioutil.ReadAll(r.Body)
+			if r.Header.Get("Content-MD5") != contentMD5(b) {
+				w.WriteHeader(400)
+				w.Write([]byte(`<Error><Code>InvalidDigest</Code></Error>`))
+				return
+			}
+			stored = b
+		case "GET":
+			if stored == nil {
+				w.WriteHeader(404)
+				w.Write([]byte(`<Error><Code>NoSuchLifecycleConfiguration</Code></Error>`))
+				return
+			}
+			w.Write(stored)
+		}
+	}))
+
+	// not configured
+	cfg, err := s3.Lifecycle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Rules) != 0 {
+		t.Fatal(cfg.Rules)
+	}
+
+	in := &LifecycleConfiguration{Rules: []LifecycleRule{{
+		ID:         "logs",
+		Prefix:     "logs/",
+		Expiration: &LifecycleExpiration{Days: 30},
+	}, {
+		Prefix:      "archive/",
+		Status:      "Disabled",
+		Transitions: []LifecycleTransition{{Days: 90, StorageClass: StorageGlacier}},
+	}}}
+	if err := s3.SetLifecycle(in); err != nil {
+		t.Fatal(err)
+	}
+	expected := `<LifecycleConfiguration>` +
+		`<Rule><ID>logs</ID><Prefix>logs/</Prefix><Status>Enabled</Status><Expiration><Days>30</Days></Expiration></Rule>` +
+		`<Rule><Prefix>archive/</Prefix><Status>Disabled</Status><Transition><Days>90</Days><StorageClass>GLACIER</StorageClass></Transition></Rule>` +
+		`</LifecycleConfiguration>`
+	if x := string(stored); x != expected {
+		t.Fatal(x)
+	}
+	if in.Rules[0].Status != "" {
+		t.Fatal("configuration was modified")
+	}
+
+	cfg, err = s3.Lifecycle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	in.Rules[0].Status = "Enabled"
+	cfg.XMLName = in.XMLName
+	if !reflect.DeepEqual(cfg, in) {
+		t.Fatal(cfg)
+	}
+}
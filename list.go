@@ -0,0 +1,200 @@
+package s3
+
+import (
+	"context"
+	"encoding/xml"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ObjectInfo describes an object in a bucket
+type ObjectInfo struct {
+	// Key is the object key, relative to the configured Path
+	Key string
+
+	Size         int64
+	ETag         string
+	LastModified time.Time
+	StorageClass StorageClass
+
+	// ContentType and Metadata are only set by Stat
+	ContentType string
+	Metadata    map[string]string
+}
+
+type listBucketResult struct {
+	IsTruncated           bool
+	NextContinuationToken string
+	Contents              []struct {
+		Key          string
+		Size         int64
+		ETag         string
+		LastModified time.Time
+		StorageClass StorageClass
+	}
+	CommonPrefixes []struct {
+		Prefix string
+	}
+}
+
+// List returns all objects with the specified key prefix. Pages are followed
+// until the listing is complete.
+func (s3 *S3) List(prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	var token string
+	for {
+		page, next, err := s3.ListPage(prefix, token)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, page...)
+		if next == "" {
+			return objects, nil
+		}
+		token = next
+	}
+}
+
+// ListPage returns a single page of up to 1000 objects with the specified key
+// prefix, starting at the continuation token. An empty token returns the
+// first page. The returned next token is empty if there are no more pages.
+//
+// http://docs.aws.amazon.com/AmazonS3/latest/API/v2-RESTBucketGET.html
+func (s3 *S3) ListPage(prefix, token string) (objects []ObjectInfo, next string, err error) {
+	objects, _, next, err = s3.listPage(prefix, "", token)
+	return objects, next, err
+}
+
+// ListDir returns the objects directly below the "directory" prefix and the
+// prefixes of its subdirectories, e.g. "photos/2020/", using "/" as the
+// delimiter. A prefix without a trailing slash is treated as a directory.
+// Pages are followed until the listing is complete.
+func (s3 *S3) ListDir(prefix string) (files []ObjectInfo, dirs []string, err error) {
+	if prefix != "" && !strings.HasSuffix(prefix, `/`) {
+		prefix += `/`
+	}
+	var token string
+	for {
+		page, prefixes, next, err := s3.listPage(prefix, `/`, token)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, page...)
+		dirs = append(dirs, prefixes...)
+		if next == "" {
+			return files, dirs, nil
+		}
+		token = next
+	}
+}
+
+// listPage returns a page of the listing. If delimiter is not empty, keys
+// containing it after the prefix are grouped into the returned prefixes.
+func (s3 *S3) listPage(prefix, delimiter, token string) (objects []ObjectInfo, prefixes []string, next string, err error) {
+	uv := make(url.Values)
+	uv.Set("list-type", "2")
+	uv.Set("prefix", s3.fullKey(prefix))
+	if delimiter != "" {
+		uv.Set("delimiter", delimiter)
+	}
+	if token != "" {
+		uv.Set("continuation-token", token)
+	}
+
+	req, err := s3.newRequest(context.Background(), "GET", `?`+uv.Encode(), nil)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	resp, err := s3.send(req, 200, "error listing objects")
+	if err != nil {
+		return nil, nil, "", err
+	}
+	defer resp.Body.Close()
+
+	var result listBucketResult
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, nil, "", err
+	}
+
+	objects = make([]ObjectInfo, len(result.Contents))
+	for i, c := range result.Contents {
+		objects[i] = ObjectInfo{
+			Key:          s3.relativeKey(c.Key),
+			Size:         c.Size,
+			ETag:         strings.Trim(c.ETag, `"`),
+			LastModified: c.LastModified,
+			StorageClass: c.StorageClass,
+		}
+	}
+
+	for _, p := range result.CommonPrefixes {
+		prefixes = append(prefixes, s3.relativeKey(p.Prefix))
+	}
+
+	if result.IsTruncated {
+		next = result.NextContinuationToken
+	}
+	return objects, prefixes, next, nil
+}
+
+// fullKey prepends the configured path to a key prefix
+func (s3 *S3) fullKey(prefix string) string {
+	if p := trim(s3.Path); p != "" {
+		return p + `/` + strings.TrimLeft(prefix, `/`)
+	}
+	return strings.TrimLeft(prefix, `/`)
+}
+
+// relativeKey strips the configured path from a key
+func (s3 *S3) relativeKey(key string) string {
+	if p := trim(s3.Path); p != "" {
+		return strings.TrimPrefix(key, p+`/`)
+	}
+	return key
+}
+
+// ObjectIterator iterates over the objects of a listing. Pages are fetched
+// lazily as the iterator advances, so only one page is held at a time.
+type ObjectIterator struct {
+	s3     *S3
+	prefix string
+	token  string
+	page   []ObjectInfo
+	cur    ObjectInfo
+	done   bool
+	err    error
+}
+
+// ListIter returns an iterator over all objects with the specified key prefix
+func (s3 *S3) ListIter(prefix string) *ObjectIterator {
+	return &ObjectIterator{s3: s3, prefix: prefix}
+}
+
+// Next advances the iterator to the next object. It returns false when the
+// listing is complete or an error occurred.
+func (it *ObjectIterator) Next() bool {
+	for len(it.page) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+		it.page, it.token, it.err = it.s3.ListPage(it.prefix, it.token)
+		if it.err != nil {
+			return false
+		}
+		it.done = it.token == ""
+	}
+	it.cur, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Object returns the current object
+func (it *ObjectIterator) Object() ObjectInfo {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *ObjectIterator) Err() error {
+	return it.err
+}
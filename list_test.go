@@ -0,0 +1,193 @@
+package s3
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// listHandler serves pages of keys, 2 keys per page
+func listHandler(t *testing.T, keys []string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if x := q.Get("list-type"); x != "2" {
+			t.Error(x)
+		}
+		if x := q.Get("prefix"); x != "path/dir/" {
+			t.Error(x)
+		}
+
+		start := 0
+		if token := q.Get("continuation-token"); token != "" {
+			fmt.Sscanf(token, "token-%d", &start)
+		}
+		end := start + 2
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		fmt.Fprint(w, `<ListBucketResult>`)
+		for _, k := range keys[start:end] {
+			fmt.Fprintf(w, `<Contents><Key>%s</Key><LastModified>2009-10-12T17:50:30.000Z</LastModified>`+
+				`<ETag>&quot;fba9dede5f27731c9771645a39863328&quot;</ETag><Size>434234</Size>`+
+				`<StorageClass>STANDARD</StorageClass></Contents>`, k)
+		}
+		if end < len(keys) {
+			fmt.Fprintf(w, `<IsTruncated>true</IsTruncated><NextContinuationToken>token-%d</NextContinuationToken>`, end)
+		} else {
+			fmt.Fprint(w, `<IsTruncated>false</IsTruncated>`)
+		}
+		fmt.Fprint(w, `</ListBucketResult>`)
+	}
+}
+
+func TestList(t *testing.T) {
+	keys := []string{"path/dir/a", "path/dir/b", "path/dir/c", "path/dir/d", "path/dir/e"}
+	s3 := newTestS3(t, listHandler(t, keys))
+	s3.Path = "path"
+
+	objects, err := s3.List("dir/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := len(objects); x != 5 {
+		t.Fatal(x)
+	}
+	for i, o := range objects {
+		if x := o.Key; x != keys[i][5:] {
+			t.Fatal(x)
+		}
+		if x := o.Size; x != 434234 {
+			t.Fatal(x)
+		}
+		if x := o.ETag; x != "fba9dede5f27731c9771645a39863328" {
+			t.Fatal(x)
+		}
+		if x := o.LastModified; !x.Equal(time.Date(2009, 10, 12, 17, 50, 30, 0, time.UTC)) {
+			t.Fatal(x)
+		}
+		if x := o.StorageClass; x != "STANDARD" {
+			t.Fatal(x)
+		}
+	}
+
+	// single page
+	page, next, err := s3.ListPage("dir/", "token-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := len(page); x != 2 {
+		t.Fatal(x)
+	}
+	if x := page[0].Key; x != "dir/c" {
+		t.Fatal(x)
+	}
+	if next != "token-4" {
+		t.Fatal(next)
+	}
+}
+
+func TestListEmpty(t *testing.T) {
+	s3 := newTestS3(t, listHandler(t, nil))
+	s3.Path = "path"
+
+	objects, err := s3.List("dir/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := len(objects); x != 0 {
+		t.Fatal(x)
+	}
+
+	_, next, err := s3.ListPage("dir/", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next != "" {
+		t.Fatal(next)
+	}
+}
+
+func TestListIter(t *testing.T) {
+	keys := []string{"path/dir/a", "path/dir/b", "path/dir/c", "path/dir/d", "path/dir/e"}
+	requests := 0
+	h := listHandler(t, keys)
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		h(w, r)
+	}))
+	s3.Path = "path"
+
+	it := s3.ListIter("dir/")
+	i := 0
+	for it.Next() {
+		if x := it.Object().Key; x != keys[i][5:] {
+			t.Fatal(x)
+		}
+		i++
+
+		// pages are fetched lazily
+		if x := requests; x != (i+1)/2 {
+			t.Fatal(i, x)
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if i != len(keys) {
+		t.Fatal(i)
+	}
+	if requests != 3 {
+		t.Fatal(requests)
+	}
+	if it.Next() {
+		t.Fatal("next after end")
+	}
+}
+
+func TestListIterError(t *testing.T) {
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(403)
+	}))
+
+	it := s3.ListIter("")
+	if it.Next() {
+		t.Fatal("next")
+	}
+	if e, ok := it.Err().(*S3Error); !ok || e.StatusCode != 403 {
+		t.Fatal(it.Err())
+	}
+}
+
+func TestListDir(t *testing.T) {
+	s3, f := newFakeS3(t)
+	s3.Path = "path"
+	for _, k := range []string{"a.txt", "dir/b.txt", "dir/c.txt", "dir/sub/d.txt", "dir/sub/deeper/e.txt", "dir/other/f.txt", "dirx/g.txt"} {
+		f.put("/path/"+k, []byte("x"), nil)
+	}
+
+	files, dirs, err := s3.ListDir("dir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, o := range files {
+		keys = append(keys, o.Key)
+	}
+	if x := fmt.Sprint(keys, dirs); x != "[dir/b.txt dir/c.txt] [dir/other/ dir/sub/]" {
+		t.Fatal(x)
+	}
+	if x := f.count("GET /?delimiter=%2F&list-type=2&prefix=path%2Fdir%2F"); x != 1 {
+		t.Fatal(x)
+	}
+
+	// root
+	files, dirs, err = s3.ListDir("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].Key != "a.txt" || fmt.Sprint(dirs) != "[dir/ dirx/]" {
+		t.Fatal(files, dirs)
+	}
+}
@@ -0,0 +1,74 @@
+package s3
+
+import (
+	"encoding/xml"
+	"net/http"
+	"time"
+)
+
+// RetentionMode is the Object Lock retention mode of an object
+type RetentionMode string
+
+const (
+	// RetentionGovernance can be overridden by users with the
+	// s3:BypassGovernanceRetention permission
+	RetentionGovernance RetentionMode = "GOVERNANCE"
+
+	// RetentionCompliance can't be overridden by any user
+	RetentionCompliance RetentionMode = "COMPLIANCE"
+)
+
+// ObjectRetention is the Object Lock retention of an object version, which
+// can't be deleted or overwritten until RetainUntilDate
+type ObjectRetention struct {
+	XMLName         xml.Name `xml:"Retention"`
+	Mode            RetentionMode
+	RetainUntilDate time.Time
+}
+
+type legalHold struct {
+	XMLName xml.Name `xml:"LegalHold"`
+	Status  string
+}
+
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectLegalHold.html
+func (o *object) LegalHold() (bool, error) {
+	var lh legalHold
+	if _, err := o.getXML("?legal-hold", "NoSuchObjectLockConfiguration", &lh, "error getting legal hold"); err != nil {
+		return false, err
+	}
+	return lh.Status == "ON", nil
+}
+
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectLegalHold.html
+func (o *object) SetLegalHold(on bool) error {
+	lh := legalHold{Status: "OFF"}
+	if on {
+		lh.Status = "ON"
+	}
+	return o.putXML("?legal-hold", &lh, nil, "error setting legal hold")
+}
+
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectRetention.html
+func (o *object) Retention() (*ObjectRetention, error) {
+	var r ObjectRetention
+	ok, err := o.getXML("?retention", "NoSuchObjectLockConfiguration", &r, "error getting retention")
+	if err != nil || !ok {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectRetention.html
+func (o *object) SetRetention(mode RetentionMode, until time.Time) error {
+	return o.setRetention(mode, until, nil)
+}
+
+func (o *object) SetRetentionBypassGovernance(mode RetentionMode, until time.Time) error {
+	return o.setRetention(mode, until, http.Header{"X-Amz-Bypass-Governance-Retention": {"true"}})
+}
+
+func (o *object) setRetention(mode RetentionMode, until time.Time, h http.Header) error {
+	r := ObjectRetention{Mode: mode, RetainUntilDate: until.UTC()}
+	return o.putXML("?retention", &r, h, "error setting retention")
+}
@@ -0,0 +1,76 @@
+package s3
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestObjectLock(t *testing.T) {
+	stored := make(map[string][]byte)
+	var bypass string
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sub := r.URL.RawQuery
+		if sub != "legal-hold" && sub != "retention" {
+			t.Error(r.URL)
+		}
+		switch r.Method {
+		case "PUT":
+			b, _ := ioutil.ReadAll(r.Body)
+			if r.Header.Get("Content-MD5") != contentMD5(b) {
+				t.Error("invalid md5")
+			}
+			stored[sub] = b
+			bypass = r.Header.Get("X-Amz-Bypass-Governance-Retention")
+		case "GET":
+			b, ok := stored[sub]
+			if !ok {
+				w.WriteHeader(404)
+				w.Write([]byte(`<Error><Code>NoSuchObjectLockConfiguration</Code></Error>`))
+				return
+			}
+			w.Write(b)
+		}
+	}))
+	o := s3.Object("key")
+
+	// not configured
+	if on, err := o.LegalHold(); err != nil || on {
+		t.Fatal(on, err)
+	}
+	if r, err := o.Retention(); err != nil || r != nil {
+		t.Fatal(r, err)
+	}
+
+	if err := o.SetLegalHold(true); err != nil {
+		t.Fatal(err)
+	}
+	if x := string(stored["legal-hold"]); x != `<LegalHold><Status>ON</Status></LegalHold>` {
+		t.Fatal(x)
+	}
+	if on, err := o.LegalHold(); err != nil || !on {
+		t.Fatal(on, err)
+	}
+
+	until := time.Date(2030, 1, 2, 4, 4, 5, 0, time.FixedZone("CET", 3600))
+	if err := o.SetRetention(RetentionGovernance, until); err != nil {
+		t.Fatal(err)
+	}
+	expected := `<Retention><Mode>GOVERNANCE</Mode><RetainUntilDate>2030-01-02T03:04:05Z</RetainUntilDate></Retention>`
+	if x := string(stored["retention"]); x != expected || bypass != "" {
+		t.Fatal(x, bypass)
+	}
+	r, err := o.Retention()
+	if err != nil || r.Mode != RetentionGovernance || !r.RetainUntilDate.Equal(until) {
+		t.Fatal(r, err)
+	}
+
+	if err := o.SetRetentionBypassGovernance(RetentionCompliance, until); err != nil {
+		t.Fatal(err)
+	}
+	expected = `<Retention><Mode>COMPLIANCE</Mode><RetainUntilDate>2030-01-02T03:04:05Z</RetainUntilDate></Retention>`
+	if x := string(stored["retention"]); x != expected || bypass != "true" {
+		t.Fatal(x, bypass)
+	}
+}
@@ -0,0 +1,214 @@
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultIMDSEndpoint = "http://169.254.169.254"
+	defaultECSEndpoint  = "http://169.254.170.2"
+
+	// imdsTokenTTL is the lifetime of IMDSv2 session tokens
+	imdsTokenTTL = 6 * time.Hour
+)
+
+// metadataClient is used for the metadata endpoints if no client is
+// configured. They are link-local, so the timeout is short to fail fast
+// outside of EC2 and ECS.
+var metadataClient = &http.Client{Timeout: time.Second}
+
+// IMDSProvider retrieves the credentials of the IAM role of an EC2 instance
+// from the instance metadata service, using IMDSv2 session tokens, which are
+// reused until they expire. Wrap it in a CredentialsCache to refresh the
+// credentials only when they expire.
+//
+// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/iam-roles-for-amazon-ec2.html
+type IMDSProvider struct {
+	// Endpoint defaults to http://169.254.169.254
+	Endpoint string
+
+	// Client defaults to a client with a timeout of 1 second
+	Client *http.Client
+
+	mu           sync.Mutex
+	token        string
+	tokenExpires time.Time
+}
+
+// Retrieve returns the credentials of the instance role
+func (p *IMDSProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	endpoint := p.Endpoint
+	if endpoint == "" {
+		endpoint = defaultIMDSEndpoint
+	}
+	endpoint = strings.TrimSuffix(endpoint, "/")
+
+	token, err := p.sessionToken(ctx, endpoint)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	get := func(path string) ([]byte, error) {
+		req, err := http.NewRequestWithContext(ctx, "GET", endpoint+path, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("X-Aws-Ec2-Metadata-Token", token)
+		return metadataGet(p.Client, req)
+	}
+
+	const path = "/latest/meta-data/iam/security-credentials/"
+	b, err := get(path)
+	if err != nil {
+		// the token may have been invalidated
+		p.mu.Lock()
+		p.token = ""
+		p.mu.Unlock()
+		return Credentials{}, err
+	}
+	// the first line is the name of the role
+	role := strings.TrimSpace(strings.SplitN(string(b), "\n", 2)[0])
+	if role == "" {
+		return Credentials{}, fmt.Errorf("s3: no IAM role in instance metadata")
+	}
+	b, err = get(path + role)
+	if err != nil {
+		return Credentials{}, err
+	}
+	return parseMetadataCredentials(b)
+}
+
+// sessionToken returns the cached IMDSv2 session token, or a new one if it
+// is about to expire
+func (p *IMDSProvider) sessionToken(ctx context.Context, endpoint string) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.token != "" && now().Add(time.Minute).Before(p.tokenExpires) {
+		return p.token, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", endpoint+"/latest/api/token", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Aws-Ec2-Metadata-Token-Ttl-Seconds", strconv.Itoa(int(imdsTokenTTL/time.Second)))
+	expires := now().Add(imdsTokenTTL)
+	b, err := metadataGet(p.Client, req)
+	if err != nil {
+		return "", err
+	}
+	p.token, p.tokenExpires = string(b), expires
+	return p.token, nil
+}
+
+// ECSProvider retrieves the credentials of the task role of an ECS container
+// from the container credentials endpoint. Wrap it in a CredentialsCache to
+// refresh them only when they expire.
+//
+// https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task-iam-roles.html
+type ECSProvider struct {
+	// Endpoint defaults to http://169.254.170.2
+	Endpoint string
+
+	// RelativeURI defaults to AWS_CONTAINER_CREDENTIALS_RELATIVE_URI
+	RelativeURI string
+
+	// Client defaults to a client with a timeout of 1 second
+	Client *http.Client
+}
+
+// Retrieve returns the credentials of the task role
+func (p *ECSProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	endpoint := p.Endpoint
+	if endpoint == "" {
+		endpoint = defaultECSEndpoint
+	}
+	uri := p.RelativeURI
+	if uri == "" {
+		uri = os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI")
+	}
+	if uri == "" {
+		return Credentials{}, fmt.Errorf("s3: AWS_CONTAINER_CREDENTIALS_RELATIVE_URI is not set")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(endpoint, "/")+uri, nil)
+	if err != nil {
+		return Credentials{}, err
+	}
+	if token := os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN"); token != "" {
+		req.Header.Set("Authorization", token)
+	}
+	b, err := metadataGet(p.Client, req)
+	if err != nil {
+		return Credentials{}, err
+	}
+	return parseMetadataCredentials(b)
+}
+
+// NewMetadataCredentials returns a cache of the credentials of the ECS task
+// role if AWS_CONTAINER_CREDENTIALS_RELATIVE_URI is set, or else of the EC2
+// instance role
+func NewMetadataCredentials() *CredentialsCache {
+	if os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI") != "" {
+		return NewCredentialsCache(&ECSProvider{})
+	}
+	return NewCredentialsCache(&IMDSProvider{})
+}
+
+// metadataGet sends the request and returns the response body
+func metadataGet(c *http.Client, req *http.Request) ([]byte, error) {
+	if c == nil {
+		c = metadataClient
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("s3: error retrieving credentials from %s: %s", req.URL.Path, resp.Status)
+	}
+	return b, nil
+}
+
+// metadataCredentials are the credentials returned by the metadata endpoints
+type metadataCredentials struct {
+	Code            string
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string
+	Token           string
+	Expiration      time.Time
+}
+
+func parseMetadataCredentials(b []byte) (Credentials, error) {
+	var mc metadataCredentials
+	if err := json.Unmarshal(b, &mc); err != nil {
+		return Credentials{}, fmt.Errorf("s3: invalid metadata credentials: %w", err)
+	}
+	if mc.Code != "" && mc.Code != "Success" {
+		return Credentials{}, fmt.Errorf("s3: error retrieving metadata credentials: %s", mc.Code)
+	}
+	if mc.AccessKeyID == "" || mc.SecretAccessKey == "" {
+		return Credentials{}, fmt.Errorf("s3: metadata credentials are incomplete")
+	}
+	return Credentials{
+		AccessKey: mc.AccessKeyID,
+		Secret:    mc.SecretAccessKey,
+		Token:     mc.Token,
+		Expires:   mc.Expiration,
+	}, nil
+}
@@ -0,0 +1,124 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// metadataServer is a stub of the instance metadata service
+type metadataServer struct {
+	requests []string
+	expires  time.Time
+}
+
+func (m *metadataServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.requests = append(m.requests, r.Method+" "+r.URL.Path)
+	switch {
+	case r.Method == "PUT" && r.URL.Path == "/latest/api/token":
+		if r.Header.Get("X-Aws-Ec2-Metadata-Token-Ttl-Seconds") == "" {
+			w.WriteHeader(400)
+			return
+		}
+		fmt.Fprint(w, "session")
+		return
+	case r.URL.Path == "/ecs/creds":
+		if r.Header.Get("Authorization") != "auth" {
+			w.WriteHeader(401)
+			return
+		}
+	default:
+		if r.Header.Get("X-Aws-Ec2-Metadata-Token") != "session" {
+			w.WriteHeader(401)
+			return
+		}
+	}
+
+	switch r.URL.Path {
+	case "/latest/meta-data/iam/security-credentials/":
+		fmt.Fprint(w, "role\n")
+	case "/latest/meta-data/iam/security-credentials/role", "/ecs/creds":
+		fmt.Fprintf(w, `{"Code":"Success","AccessKeyId":"key%d","SecretAccessKey":"secret","Token":"token","Expiration":%q}`,
+			len(m.requests), m.expires.Format(time.RFC3339))
+	default:
+		w.WriteHeader(404)
+	}
+}
+
+func TestIMDSProvider(t *testing.T) {
+	defer func(f func() time.Time) { now = f }(now)
+	start := time.Now().Truncate(time.Second)
+	now = func() time.Time { return start }
+
+	m := &metadataServer{expires: start.Add(time.Hour)}
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	c := NewCredentialsCache(&IMDSProvider{Endpoint: srv.URL})
+	creds, err := c.Retrieve(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.AccessKey != "key3" || creds.Secret != "secret" || creds.Token != "token" || !creds.Expires.Equal(start.Add(time.Hour)) {
+		t.Fatal(creds)
+	}
+	want := []string{
+		"PUT /latest/api/token",
+		"GET /latest/meta-data/iam/security-credentials/",
+		"GET /latest/meta-data/iam/security-credentials/role",
+	}
+	if fmt.Sprint(m.requests) != fmt.Sprint(want) {
+		t.Fatal(m.requests)
+	}
+
+	// cached until shortly before they expire
+	if _, err := c.Retrieve(context.Background()); err != nil || len(m.requests) != 3 {
+		t.Fatal(m.requests, err)
+	}
+	// the session token is reused
+	now = func() time.Time { return start.Add(56 * time.Minute) }
+	if creds, err := c.Retrieve(context.Background()); err != nil || creds.AccessKey != "key5" {
+		t.Fatal(creds, err)
+	}
+
+	// until it expires
+	m.expires = start.Add(7 * time.Hour)
+	now = func() time.Time { return start.Add(6 * time.Hour) }
+	if creds, err := c.Retrieve(context.Background()); err != nil || creds.AccessKey != "key8" {
+		t.Fatal(creds, err)
+	}
+	if x := m.requests[5]; x != "PUT /latest/api/token" {
+		t.Fatal(m.requests)
+	}
+}
+
+func TestECSProvider(t *testing.T) {
+	m := &metadataServer{expires: time.Now().Add(time.Hour)}
+	srv := httptest.NewServer(m)
+	defer srv.Close()
+
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI", "/ecs/creds")
+	t.Setenv("AWS_CONTAINER_AUTHORIZATION_TOKEN", "auth")
+	creds, err := (&ECSProvider{Endpoint: srv.URL}).Retrieve(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.AccessKey != "key1" || creds.Token != "token" {
+		t.Fatal(creds)
+	}
+
+	if _, ok := NewMetadataCredentials().provider.(*ECSProvider); !ok {
+		t.Fatal("expected ECS provider")
+	}
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI", "")
+	if _, ok := NewMetadataCredentials().provider.(*IMDSProvider); !ok {
+		t.Fatal("expected IMDS provider")
+	}
+
+	if _, err := (&ECSProvider{Endpoint: srv.URL, RelativeURI: "/missing"}).Retrieve(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+}
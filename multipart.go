@@ -0,0 +1,242 @@
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MultipartUpload describes an in-progress multipart upload
+type MultipartUpload struct {
+	// Key is the object key, relative to the configured Path
+	Key string
+
+	UploadId  string
+	Initiated time.Time
+}
+
+// UploadedPart describes a part of an in-progress multipart upload
+type UploadedPart struct {
+	PartNumber   int
+	ETag         string
+	Size         int64
+	LastModified time.Time
+}
+
+type listMultipartUploadsResult struct {
+	IsTruncated        bool
+	NextKeyMarker      string
+	NextUploadIdMarker string
+	Upload             []struct {
+		Key       string
+		UploadId  string
+		Initiated time.Time
+	}
+}
+
+type listPartsResult struct {
+	IsTruncated          bool
+	NextPartNumberMarker int
+	Part                 []UploadedPart
+}
+
+// ListMultipartUploads returns the in-progress multipart uploads of objects
+// with the specified key prefix. Pages are followed until the listing is
+// complete.
+//
+// http://docs.aws.amazon.com/AmazonS3/latest/API/mpUploadListMPUpload.html
+func (s3 *S3) ListMultipartUploads(prefix string) ([]MultipartUpload, error) {
+	uploads, err := s3.listMultipartUploads(context.Background(), s3.fullKey(prefix))
+	if err != nil {
+		return nil, err
+	}
+	for i := range uploads {
+		uploads[i].Key = s3.relativeKey(uploads[i].Key)
+	}
+	return uploads, nil
+}
+
+// listMultipartUploads returns the uploads with the specified full key prefix
+func (s3 *S3) listMultipartUploads(ctx context.Context, prefix string) ([]MultipartUpload, error) {
+	var uploads []MultipartUpload
+	var keyMarker, idMarker string
+	for {
+		uv := make(url.Values)
+		uv.Set("uploads", "")
+		uv.Set("prefix", prefix)
+		if keyMarker != "" {
+			uv.Set("key-marker", keyMarker)
+			uv.Set("upload-id-marker", idMarker)
+		}
+
+		req, err := s3.newRequest(ctx, "GET", `?`+uv.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s3.send(req, 200, "error listing multipart uploads")
+		if err != nil {
+			return nil, err
+		}
+		var result listMultipartUploadsResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, u := range result.Upload {
+			uploads = append(uploads, MultipartUpload{
+				Key:       u.Key,
+				UploadId:  u.UploadId,
+				Initiated: u.Initiated,
+			})
+		}
+		if !result.IsTruncated || result.NextKeyMarker == "" {
+			return uploads, nil
+		}
+		keyMarker, idMarker = result.NextKeyMarker, result.NextUploadIdMarker
+	}
+}
+
+// AbortMultipartUploadsOlderThan aborts the in-progress multipart uploads of
+// objects with the specified key prefix that were initiated more than age
+// ago, and returns the number of aborted uploads.
+func (s3 *S3) AbortMultipartUploadsOlderThan(prefix string, age time.Duration) (int, error) {
+	ctx := context.Background()
+	uploads, err := s3.listMultipartUploads(ctx, s3.fullKey(prefix))
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	cutoff := now().Add(-age)
+	for _, u := range uploads {
+		if !u.Initiated.Before(cutoff) {
+			continue
+		}
+		o := s3.ObjectRaw(u.Key).(*object)
+		if err := o.abortUpload(ctx, u.UploadId); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+func (o *object) MultipartUploads() ([]MultipartUpload, error) {
+	uploads, err := o.s3.listMultipartUploads(context.Background(), o.Key())
+	if err != nil {
+		return nil, err
+	}
+
+	// the listing includes other keys with the same prefix
+	var result []MultipartUpload
+	for _, u := range uploads {
+		if u.Key == o.Key() {
+			u.Key = o.s3.relativeKey(u.Key)
+			result = append(result, u)
+		}
+	}
+	return result, nil
+}
+
+// abortUpload aborts the multipart upload with the specified id
+func (o *object) abortUpload(ctx context.Context, uploadId string) error {
+	uv := make(url.Values)
+	uv.Set("uploadId", uploadId)
+	req, err := o.newRequest(ctx, "DELETE", `?`+uv.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := o.s3.send(req, 204, "could not abort upload")
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (o *object) ListParts(uploadId string) ([]UploadedPart, error) {
+	return o.listParts(context.Background(), uploadId)
+}
+
+// listParts returns all uploaded parts of a multipart upload
+//
+// http://docs.aws.amazon.com/AmazonS3/latest/API/mpUploadListParts.html
+func (o *object) listParts(ctx context.Context, uploadId string) ([]UploadedPart, error) {
+	var parts []UploadedPart
+	marker := 0
+	for {
+		uv := make(url.Values)
+		uv.Set("uploadId", uploadId)
+		if marker > 0 {
+			uv.Set("part-number-marker", strconv.Itoa(marker))
+		}
+
+		req, err := o.newRequest(ctx, "GET", `?`+uv.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := o.s3.send(req, 200, "error listing parts")
+		if err != nil {
+			return nil, err
+		}
+		var result listPartsResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range result.Part {
+			p.ETag = strings.Trim(p.ETag, `"`)
+			parts = append(parts, p)
+		}
+		if !result.IsTruncated || result.NextPartNumberMarker <= marker {
+			return parts, nil
+		}
+		marker = result.NextPartNumberMarker
+	}
+}
+
+func (o *object) ResumableWriter(uploadId string) Writer {
+	w := newWriter(context.Background(), o, UploadOptions{})
+	w.uploadId = uploadId
+	// the part size must be known before the first part is cut
+	if w.err == nil {
+		w.err = w.resume()
+	}
+	w.prepared = w.err == nil
+	return w
+}
+
+// resume lists the parts uploaded so far and adopts their part size
+func (w *writer) resume() error {
+	parts, err := w.o.listParts(w.ctx, w.uploadId)
+	if err != nil {
+		return err
+	}
+	w.uploaded = make(map[int]UploadedPart, len(parts))
+	for _, p := range parts {
+		w.uploaded[p.PartNumber] = p
+		if p.PartNumber == 1 && len(parts) > 1 {
+			w.partSize = p.Size
+		}
+	}
+	return nil
+}
+
+// isUploaded reports if part n was already uploaded with content b
+func (w *writer) isUploaded(n int, b []byte) (string, bool) {
+	p, ok := w.uploaded[n]
+	if !ok || p.Size != int64(len(b)) {
+		return "", false
+	}
+	sum := md5.Sum(b)
+	return p.ETag, hex.EncodeToString(sum[:]) == p.ETag
+}
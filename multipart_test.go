@@ -0,0 +1,172 @@
+package s3
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestListMultipartUploads(t *testing.T) {
+	s3, f := newFakeS3(t)
+	s3.Path = "dir"
+	f.pageSize = 2
+
+	t0 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.startUpload("/dir/a", t0)
+	f.startUpload("/dir/b", t0)
+	f.startUpload("/dir/a", t0)
+	f.startUpload("/dir/ab", t0)
+	f.startUpload("/other", t0)
+
+	uploads, err := s3.ListMultipartUploads("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, u := range uploads {
+		keys = append(keys, u.Key+":"+u.UploadId)
+		if !u.Initiated.Equal(t0) {
+			t.Fatal(u.Initiated)
+		}
+	}
+	if x := strings.Join(keys, " "); x != "a:1 a:3 ab:4" {
+		t.Fatal(x)
+	}
+	if x := f.count("GET /?prefix=dir%2Fa&uploads"); x != 1 {
+		t.Fatal(x)
+	}
+
+	uploads, err = s3.Object("a").MultipartUploads()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(uploads) != 2 || uploads[0].Key != "a" || uploads[1].UploadId != "3" {
+		t.Fatal(uploads)
+	}
+}
+
+func TestResumableWriter(t *testing.T) {
+	s3, f := newFakeS3(t)
+	o := s3.Object("key")
+	data := bytes.Repeat([]byte("0123456789"), (4*MinPartSize+MinPartSize/2)/10)
+
+	// upload the first two parts and interrupt
+	w := o.WriterWithOptions(UploadOptions{Concurrency: 1})
+	if _, err := w.Write(data[:2*MinPartSize+1]); err != nil {
+		t.Fatal(err)
+	}
+	// wait for the parts to be uploaded without completing
+	for f.count("PUT /key?partNumber=") < 2 {
+		time.Sleep(time.Millisecond)
+	}
+
+	uploads, err := o.MultipartUploads()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(uploads) != 1 {
+		t.Fatal(uploads)
+	}
+	id := uploads[0].UploadId
+
+	f.pageSize = 1
+	parts, err := o.ListParts(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parts) != 2 || parts[1].PartNumber != 2 || parts[1].Size != MinPartSize {
+		t.Fatal(parts)
+	}
+
+	w = o.ResumableWriter(id)
+	if _, err := io.Copy(w, bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// parts 3 to 5 are uploaded again
+	if x := f.count("PUT /key?partNumber="); x != 5 {
+		t.Fatal(x)
+	}
+	if x := f.count("POST /key?uploads"); x != 1 {
+		t.Fatal(x)
+	}
+	if x := f.get("/key").body; !bytes.Equal(x, data) {
+		t.Fatal(len(x))
+	}
+}
+
+func TestResumableWriterPartSize(t *testing.T) {
+	s3, f := newFakeS3(t)
+	o := s3.Object("key")
+	partSize := int64(MinPartSize + 1000)
+	data := bytes.Repeat([]byte("0123456789"), int(4*partSize-500)/10)
+
+	w := o.WriterWithOptions(UploadOptions{Concurrency: 1, PartSize: partSize})
+	if _, err := w.Write(data[:2*partSize+1]); err != nil {
+		t.Fatal(err)
+	}
+	for f.count("PUT /key?partNumber=") < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	uploads, err := o.MultipartUploads()
+	if err != nil || len(uploads) != 1 {
+		t.Fatal(uploads, err)
+	}
+
+	// the parts are cut at the stored part size, so only parts 3 and 4 are
+	// uploaded again
+	w = o.ResumableWriter(uploads[0].UploadId)
+	if _, err := io.Copy(w, bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if x := f.count("PUT /key?partNumber="); x != 4 {
+		t.Fatal(x)
+	}
+	if x := f.get("/key").body; !bytes.Equal(x, data) {
+		t.Fatal(len(x))
+	}
+}
+
+func TestAbortMultipartUploadsOlderThan(t *testing.T) {
+	s3, f := newFakeS3(t)
+	f.pageSize = 1
+
+	t0 := time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC)
+	defer func(f func() time.Time) { now = f }(now)
+	now = func() time.Time { return t0 }
+
+	f.startUpload("/tmp/a", t0.Add(-48*time.Hour))
+	f.startUpload("/tmp/b", t0.Add(-time.Hour))
+	f.startUpload("/tmp/c", t0.Add(-25*time.Hour))
+	f.startUpload("/keep/d", t0.Add(-48*time.Hour))
+
+	n, err := s3.AbortMultipartUploadsOlderThan("tmp/", 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatal(n)
+	}
+	if x := f.count("DELETE /tmp/a?uploadId=1"); x != 1 {
+		t.Fatal(x)
+	}
+	if x := f.count("DELETE /tmp/c?uploadId=3"); x != 1 {
+		t.Fatal(x)
+	}
+
+	uploads, err := s3.ListMultipartUploads("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(uploads) != 2 || uploads[0].Key != "keep/d" || uploads[1].Key != "tmp/b" {
+		t.Fatal(uploads)
+	}
+}
@@ -1,10 +1,13 @@
 package s3
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
 	"crypto/hmac"
 	"crypto/sha1"
 	"encoding/base64"
-	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"io"
 	"net/http"
@@ -26,9 +29,9 @@ const (
 )
 
 const (
-	s3proto = `https`
-	s3servicehost  = `s3`
-	s3awshost  = `amazonaws.com`
+	s3proto       = `https`
+	s3servicehost = `s3`
+	s3awshost     = `amazonaws.com`
 )
 
 type Object interface {
@@ -39,34 +42,299 @@ type Object interface {
 	// S3 returns the configuration this object is bound to.
 	S3() S3
 
+	// WithHeader returns a copy of the object, whose requests include the
+	// specified header, e.g. x-amz-expected-bucket-owner. The header is
+	// signed like the headers set by the package.
+	WithHeader(key, value string) Object
+
 	// Writer returns a new upload io.Writer
 	Writer() Writer
 
+	// WriterContext is like Writer, but all upload requests are bound to ctx
+	WriterContext(ctx context.Context) Writer
+
+	// WriterWithOptions returns a new upload io.Writer, which applies opts to
+	// the uploaded object
+	WriterWithOptions(opts UploadOptions) Writer
+
+	// UploaderAt returns a new uploader for data that is written at
+	// arbitrary offsets, which applies opts to the uploaded object. Data
+	// can't be compressed out of order, so writes fail if opts.Gzip is set.
+	UploaderAt(opts UploadOptions) UploaderAt
+
+	// ResumableWriter returns a new upload io.Writer that continues the
+	// multipart upload with the specified id. The object must be written again
+	// from the start; parts that were already uploaded with the same content
+	// are skipped.
+	ResumableWriter(uploadId string) Writer
+
+	// MultipartUploads returns the in-progress multipart uploads of the object
+	MultipartUploads() ([]MultipartUpload, error)
+
+	// ListParts returns the parts uploaded so far to the multipart upload
+	// with the specified id
+	ListParts(uploadId string) ([]UploadedPart, error)
+
+	// Put uploads the object in a single request. If size is negative, r is
+	// read into memory to determine it. Use a Writer for objects larger than
+	// a few megabytes.
+	Put(r io.Reader, size int64, opts ...PutOption) error
+
+	// PutSeeker uploads the data from the current position of r to its end.
+	// The size is determined by seeking, and objects up to the single PUT
+	// threshold are streamed with a single request after reading them once
+	// for Content-MD5, without holding them in memory. Larger objects and
+	// Gzip uploads use a multipart upload. On failure, r is restored to the
+	// start position.
+	PutSeeker(r io.ReadSeeker, opts ...PutOption) error
+
 	// Reader returns a new ReadCloser to read the file
 	Reader() (io.ReadCloser, http.Header, error)
 
-	// Exists checks if an object with the specified key already exists
+	// ReaderContext is like Reader, but the download is bound to ctx.
+	// Cancelling ctx aborts the transfer.
+	ReaderContext(ctx context.Context) (io.ReadCloser, http.Header, error)
+
+	// WriteTo downloads the object to w and returns the number of bytes
+	// written. It implements io.WriterTo.
+	WriteTo(w io.Writer) (int64, error)
+
+	// ReaderVersion is like Reader, but reads the specified version of the
+	// object in a versioned bucket
+	ReaderVersion(versionId string) (io.ReadCloser, http.Header, error)
+
+	// ReaderWithProgress is like Reader, but fn is called periodically with
+	// the number of bytes read
+	ReaderWithProgress(fn ProgressFunc) (io.ReadCloser, http.Header, error)
+
+	// ReaderWithRateLimit is like ReaderContext, but reads no more than
+	// bytesPerSec bytes per second
+	ReaderWithRateLimit(ctx context.Context, bytesPerSec int64) (io.ReadCloser, http.Header, error)
+
+	// ReaderWithChecksum is like Reader, but requests the additional checksum
+	// of the object. If the object was uploaded in a single request with a
+	// checksum, reading to EOF returns ErrChecksumMismatch if the data doesn't
+	// match.
+	ReaderWithChecksum() (io.ReadCloser, http.Header, error)
+
+	// ReaderVerifyETag is like Reader, but verifies the data against the
+	// ETag if it is the MD5 of the object, which is not the case for
+	// multipart uploads, KMS encryption and customer-provided keys (SSE-C).
+	// If the data doesn't match, reading to EOF and Close return
+	// ErrChecksumMismatch.
+	ReaderVerifyETag() (io.ReadCloser, http.Header, error)
+
+	// ReaderResumable is like ReaderContext, but if reading fails midway,
+	// e.g. because the connection dropped, it continues with a ranged GET
+	// request from the current offset, up to maxResumes times. Reading fails
+	// with ErrObjectChanged if the object was replaced in the meantime.
+	ReaderResumable(ctx context.Context, maxResumes int) (io.ReadCloser, http.Header, error)
+
+	// ReaderDecoded is like Reader, but decompresses objects stored with
+	// Content-Encoding gzip, zstd or br, or an encoding added with
+	// RegisterDecoder. Other encodings are returned unchanged. The returned
+	// header is the header of the stored object.
+	ReaderDecoded() (io.ReadCloser, http.Header, error)
+
+	// ReaderRange returns a new ReadCloser to read the bytes start through end
+	// (inclusive) of the file. An end of -1 reads to the end of the file. The
+	// Content-Range header of the response contains the total size.
+	ReaderRange(start, end int64) (io.ReadCloser, http.Header, error)
+
+	// ReaderRangeIfMatch is like ReaderRange, but fails with ErrObjectChanged
+	// if the ETag of the object is no longer etag, e.g. because it was
+	// replaced after it was inspected with Head.
+	ReaderRangeIfMatch(etag string, start, end int64) (io.ReadCloser, http.Header, error)
+
+	// ReaderAt returns an io.ReaderAt for the object, which reads with ranged
+	// GET requests, e.g. to open archives without downloading them
+	ReaderAt() (*ObjectReaderAt, error)
+
+	// ReaderIfChanged is like Reader, but only downloads the file if its ETag
+	// differs from etag or it was modified after since. Empty or zero
+	// conditions are ignored. If the file is unchanged, ErrNotModified is
+	// returned.
+	ReaderIfChanged(etag string, since time.Time) (io.ReadCloser, http.Header, error)
+
+	// DownloadToFile downloads the object to the file at path, fetching parts
+	// of partSize bytes with up to concurrency parallel range requests. The
+	// file is only created if the download succeeds. If the object is
+	// replaced during the download, ErrObjectChanged is returned and the
+	// download can be restarted.
+	DownloadToFile(path string, concurrency int, partSize int64) error
+
+	// DownloadToFileWithOptions is like DownloadToFile, but applies opts.
+	// Unless opts.PinETag or opts.IfMatch is set, a replacement of the object
+	// during the download isn't detected.
+	DownloadToFileWithOptions(path string, opts DownloadOptions) error
+
+	// Exists checks if an object with the specified key already exists. If S3
+	// responds with a status other than 200 or 404, an error is returned.
 	Exists() (bool, error)
 
+	// ExistsContext is like Exists, but the request is bound to ctx
+	ExistsContext(ctx context.Context) (bool, error)
+
 	// Delete deletes an object
 	Delete() error
 
+	// DeleteContext is like Delete, but the request is bound to ctx
+	DeleteContext(ctx context.Context) error
+
+	// DeleteIf deletes the object only if the preconditions are met.
+	// Otherwise ErrPreconditionFailed is returned.
+	DeleteIf(p Preconditions) error
+
+	// DeleteVersion permanently deletes the specified version of the object
+	DeleteVersion(versionId string) error
+
 	// Head does a HEAD request and returns the header
 	Head() (Header, error)
 
-	// ExpiringURL returns a signed, expiring URL for the object
+	// HeadContext is like Head, but the request is bound to ctx
+	HeadContext(ctx context.Context) (Header, error)
+
+	// HeadVersion is like Head, but returns the header of the specified
+	// version of the object
+	HeadVersion(versionId string) (Header, error)
+
+	// HeadWithChecksum is like Head, but the header includes the additional
+	// checksum of the object, if any
+	HeadWithChecksum() (Header, error)
+
+	// Stat returns the parsed header of the object, with the key as List
+	// reports it. If the object does not exist, ErrNotFound is returned.
+	Stat() (*ObjectInfo, error)
+
+	// Size returns the size of the object in bytes. If the object does not
+	// exist, ErrNotFound is returned.
+	Size() (int64, error)
+
+	// Attributes returns the specified attributes of the object, e.g.
+	// AttributeObjectParts, in a single request. If no attributes are
+	// specified, all are returned.
+	Attributes(fields ...string) (*ObjectAttributes, error)
+
+	// Select runs an SQL query on the CSV or JSON object on the server and
+	// returns a reader of the matching records
+	Select(query string, input InputFormat, output OutputFormat) (io.ReadCloser, error)
+
+	// CopyFrom does a server-side copy of the object with the specified key
+	// in the same bucket to this object
+	CopyFrom(sourceKey string) error
+
+	// CopyFromObject does a server-side copy of src, which may be located in
+	// another bucket, to this object. Sources larger than 5 GiB are copied
+	// with a multipart upload.
+	CopyFromObject(src Object) error
+
+	// CopyFromWithOptions is like CopyFromObject, but applies opts to the
+	// copy
+	CopyFromWithOptions(src Object, opts CopyOptions) error
+
+	// MoveTo renames the object to destKey in the same bucket with a
+	// server-side copy and a delete. If the delete fails and the source
+	// still exists, the copy is deleted again.
+	MoveTo(destKey string) error
+
+	// MoveToObject is like MoveTo, but moves the object to dst, which may
+	// be in another bucket
+	MoveToObject(dst Object) error
+
+	// Tags returns the tag set of the object
+	Tags() (map[string]string, error)
+
+	// SetTags replaces the tag set of the object. An empty set removes all
+	// tags.
+	SetTags(tags map[string]string) error
+
+	// Restore restores an archived object for the specified number of days
+	// with the retrieval tier. An empty tier uses TierStandard. If a restore
+	// is already in progress, ErrRestoreInProgress is returned.
+	Restore(days int, tier RestoreTier) error
+
+	// Torrent returns a reader of the .torrent file of the object
+	Torrent() (io.ReadCloser, error)
+
+	// LegalHold reports if an Object Lock legal hold is placed on the object
+	LegalHold() (bool, error)
+
+	// SetLegalHold places or removes an Object Lock legal hold. Objects with
+	// a legal hold can't be deleted or overwritten.
+	SetLegalHold(on bool) error
+
+	// Retention returns the Object Lock retention of the object, or nil if
+	// it has none
+	Retention() (*ObjectRetention, error)
+
+	// SetRetention protects the object with the retention mode until the
+	// specified time. The bucket must have Object Lock enabled.
+	SetRetention(mode RetentionMode, until time.Time) error
+
+	// SetRetentionBypassGovernance is like SetRetention, but may shorten or
+	// remove a RetentionGovernance retention, which requires the
+	// s3:BypassGovernanceRetention permission
+	SetRetentionBypassGovernance(mode RetentionMode, until time.Time) error
+
+	// ACL returns the access control policy of the object
+	ACL() (*AccessControlPolicy, error)
+
+	// SetACL replaces the access control policy of the object with a canned
+	// ACL
+	SetACL(acl ACL) error
+
+	// SetACLPolicy replaces the access control policy of the object with
+	// explicit grants. The owner must be set and usually keeps
+	// FULL_CONTROL.
+	SetACLPolicy(policy *AccessControlPolicy) error
+
+	// ExpiringURL returns a signed, expiring URL for the object. With
+	// signature version 4, URLs expire after at most 7 days.
 	ExpiringURL(expiresIn time.Duration) (*url.URL, error)
 
-	// FormURL returns a signed URL for multipart form uploads
+	// ExpiringURLAt is like ExpiringURL, but the URL is valid for expiresIn
+	// from start instead of from now, e.g. for URLs that are sent later. With
+	// signature version 4, the URL is not valid before start.
+	ExpiringURLAt(start time.Time, expiresIn time.Duration) (*url.URL, error)
+
+	// ExpiringURLWithResponseHeaders is like ExpiringURL, but S3 responds
+	// with the specified headers, e.g. to download the object as an
+	// attachment.
+	ExpiringURLWithResponseHeaders(expiresIn time.Duration, rh ResponseHeaders) (*url.URL, error)
+
+	// PresignPut returns a signed, expiring URL to upload the object with a
+	// PUT request. If contentType is not empty, it is part of the signature
+	// and the upload must set the same Content-Type header.
+	PresignPut(expiresIn time.Duration, contentType string) (*url.URL, error)
+
+	// FormUpload returns the action URL and the fields of an HTML form for
+	// browser-based POST uploads. If a session token is configured, the
+	// policy must contain a matching "x-amz-security-token" condition. With
+	// signature version 4, the conditions of the x-amz-* fields are added to
+	// the policy.
+	FormUpload(acl ACL, policy Policy) (*PostForm, error)
+
+	// FormURL returns a signed URL for multipart form uploads. If a session
+	// token is configured, the policy must contain a matching
+	// "x-amz-security-token" condition.
 	FormURL(acl ACL, policy Policy, query ...url.Values) (*url.URL, error)
 }
 
 type object struct {
 	key string
 	s3  S3
+
+	// raw is set if key is used unchanged
+	raw bool
+
+	// header is added to all requests
+	header http.Header
 }
 
 func (o *object) Key() string {
+	if o.raw {
+		return o.key
+	}
 	if p := trim(o.s3.Path); p != "" {
 		return p + `/` + trim(o.key)
 	}
@@ -78,29 +346,187 @@ func (o *object) S3() S3 {
 }
 
 func (o *object) Writer() Writer {
-	return newWriter(o)
+	return o.WriterContext(context.Background())
+}
+
+func (o *object) WriterContext(ctx context.Context) Writer {
+	return newWriter(ctx, o, UploadOptions{})
+}
+
+func (o *object) WriterWithOptions(opts UploadOptions) Writer {
+	w := newWriter(context.Background(), o, opts)
+	if opts.Gzip {
+		return &gzipWriter{gz: gzip.NewWriter(w), w: w}
+	}
+	return w
 }
 
 func (o *object) Reader() (io.ReadCloser, http.Header, error) {
-	resp, err := o.request("GET", 200, "error creating reader")
+	return o.ReaderContext(context.Background())
+}
+
+func (o *object) ReaderContext(ctx context.Context) (io.ReadCloser, http.Header, error) {
+	resp, err := o.request(ctx, "GET", 200, "error creating reader")
+	if err != nil {
+		return nil, nil, err
+	}
+	return &ctxReader{ctx: ctx, rc: resp.Body}, resp.Header, nil
+}
+
+// Torrent returns the .torrent file of the object
+//
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectTorrent.html
+func (o *object) Torrent() (io.ReadCloser, error) {
+	req, err := o.newRequest(context.Background(), "GET", "?torrent", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := o.s3.send(req, 200, "error getting torrent")
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (o *object) WriteTo(w io.Writer) (int64, error) {
+	r, _, err := o.Reader()
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+	return io.Copy(w, r)
+}
+
+func (o *object) ReaderVersion(versionId string) (io.ReadCloser, http.Header, error) {
+	req, err := o.newRequest(context.Background(), "GET", versionQuery(versionId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := o.s3.send(req, 200, "error creating reader")
 	if err != nil {
 		return nil, nil, err
 	}
 	return resp.Body, resp.Header, nil
 }
 
+// versionQuery returns the query selecting an object version
+func versionQuery(versionId string) string {
+	return `?versionId=` + url.QueryEscape(versionId)
+}
+
+func (o *object) ReaderWithProgress(fn ProgressFunc) (io.ReadCloser, http.Header, error) {
+	rc, h, err := o.Reader()
+	if err != nil {
+		return nil, nil, err
+	}
+	total, err := Header(h).ContentLength()
+	if err != nil {
+		total = -1
+	}
+	return &progressReader{rc: rc, p: newProgress(fn, total)}, h, nil
+}
+
+func (o *object) ReaderRange(start, end int64) (io.ReadCloser, http.Header, error) {
+	return o.readerRange(context.Background(), "", start, end)
+}
+
+func (o *object) ReaderRangeIfMatch(etag string, start, end int64) (io.ReadCloser, http.Header, error) {
+	return o.readerRange(context.Background(), etag, start, end)
+}
+
+// readerRange returns a reader for the bytes start through end. If etag is
+// not empty, the object must still have that ETag.
+func (o *object) readerRange(ctx context.Context, etag string, start, end int64) (io.ReadCloser, http.Header, error) {
+	req, err := o.newRequest(ctx, "GET", "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Range", byteRange(start, end))
+	if etag != "" {
+		req.Header.Set("If-Match", `"`+strings.Trim(etag, `"`)+`"`)
+	}
+
+	resp, err := o.s3.send(req, 0, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	switch resp.StatusCode {
+	case 206:
+		return &ctxReader{ctx: ctx, rc: resp.Body}, resp.Header, nil
+	case 412:
+		if etag != "" {
+			resp.Body.Close()
+			return nil, nil, ErrObjectChanged
+		}
+	}
+	err = newS3Error(resp, "error creating range reader (%s)", http.StatusText(resp.StatusCode))
+	resp.Body.Close()
+	return nil, nil, err
+}
+
+func (o *object) ReaderIfChanged(etag string, since time.Time) (io.ReadCloser, http.Header, error) {
+	req, err := o.newRequest(context.Background(), "GET", "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", `"`+strings.Trim(etag, `"`)+`"`)
+	}
+	if !since.IsZero() {
+		req.Header.Set("If-Modified-Since", since.UTC().Format(http.TimeFormat))
+	}
+
+	resp, err := o.s3.send(req, 0, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	switch resp.StatusCode {
+	case 200:
+		return resp.Body, resp.Header, nil
+	case 304:
+		resp.Body.Close()
+		return nil, resp.Header, ErrNotModified
+	}
+	err = newS3Error(resp, "error creating reader (%s)", http.StatusText(resp.StatusCode))
+	resp.Body.Close()
+	return nil, nil, err
+}
+
+// byteRange returns the Range header value for the bytes start through end.
+// An end of -1 means to the end of the object.
+func byteRange(start, end int64) string {
+	if end < 0 {
+		return fmt.Sprintf("bytes=%d-", start)
+	}
+	return fmt.Sprintf("bytes=%d-%d", start, end)
+}
+
 func (o *object) Exists() (bool, error) {
-	resp, err := o.request("HEAD", 0, "")
+	return o.ExistsContext(context.Background())
+}
+
+func (o *object) ExistsContext(ctx context.Context) (bool, error) {
+	resp, err := o.request(ctx, "HEAD", 0, "")
 	if err != nil {
 		return false, err
 	}
 	resp.Body.Close()
-	return (resp.StatusCode == 200), err
 
+	switch resp.StatusCode {
+	case 200:
+		return true, nil
+	case 404:
+		return false, nil
+	}
+	return false, newS3Error(resp, "error checking existence (%s)", http.StatusText(resp.StatusCode))
 }
 
 func (o *object) Delete() error {
-	resp, err := o.request("DELETE", 204, "error deleting object")
+	return o.DeleteContext(context.Background())
+}
+
+func (o *object) DeleteContext(ctx context.Context) error {
+	resp, err := o.request(ctx, "DELETE", 204, "error deleting object")
 	if err != nil {
 		return err
 	}
@@ -108,8 +534,58 @@ func (o *object) Delete() error {
 	return err
 }
 
+func (o *object) DeleteIf(p Preconditions) error {
+	req, err := o.newRequest(context.Background(), "DELETE", "", nil)
+	if err != nil {
+		return err
+	}
+	p.setHeaders(req.Header)
+
+	resp, err := o.s3.send(req, 204, "error deleting object")
+	if err != nil {
+		return preconditionError(err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (o *object) DeleteVersion(versionId string) error {
+	req, err := o.newRequest(context.Background(), "DELETE", versionQuery(versionId), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := o.s3.send(req, 204, "error deleting object version")
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
 func (o *object) Head() (Header, error) {
-	resp, err := o.request("HEAD", 200, "error getting head")
+	return o.HeadContext(context.Background())
+}
+
+func (o *object) HeadContext(ctx context.Context) (Header, error) {
+	return o.head(ctx, "", false)
+}
+
+func (o *object) HeadVersion(versionId string) (Header, error) {
+	return o.head(context.Background(), versionQuery(versionId), false)
+}
+
+// head does a HEAD request. If checksum is set, the additional checksum of
+// the object is requested as well.
+func (o *object) head(ctx context.Context, query string, checksum bool) (Header, error) {
+	req, err := o.newRequest(ctx, "HEAD", query, nil)
+	if err != nil {
+		return nil, err
+	}
+	if checksum {
+		req.Header.Set("X-Amz-Checksum-Mode", "ENABLED")
+	}
+
+	resp, err := o.s3.send(req, 200, "error getting head")
 	if err != nil {
 		return nil, err
 	}
@@ -117,13 +593,141 @@ func (o *object) Head() (Header, error) {
 	return Header(resp.Header), nil
 }
 
+func (o *object) Size() (int64, error) {
+	h, err := o.statHead(context.Background())
+	if err != nil {
+		return 0, err
+	}
+	size, err := h.ContentLength()
+	if err != nil {
+		return 0, fmt.Errorf("s3: invalid Content-Length: %v", err)
+	}
+	return size, nil
+}
+
+// statHead returns the header of the object, or ErrNotFound if it doesn't
+// exist
+func (o *object) statHead(ctx context.Context) (Header, error) {
+	resp, err := o.request(ctx, "HEAD", 0, "")
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case 200:
+		return Header(resp.Header), nil
+	case 404:
+		return nil, ErrNotFound
+	}
+	return nil, newS3Error(resp, "error getting head (%s)", http.StatusText(resp.StatusCode))
+}
+
+func (o *object) Stat() (*ObjectInfo, error) {
+	h, err := o.statHead(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	size, err := h.ContentLength()
+	if err != nil {
+		return nil, fmt.Errorf("s3: invalid Content-Length: %v", err)
+	}
+	modified, err := h.LastModified()
+	if err != nil {
+		return nil, fmt.Errorf("s3: invalid Last-Modified: %v", err)
+	}
+	return &ObjectInfo{
+		Key:          o.s3.relativeKey(o.Key()),
+		Size:         size,
+		ETag:         strings.Trim(h.ETag(), `"`),
+		LastModified: modified,
+		StorageClass: h.StorageClass(),
+		ContentType:  h.ContentType(),
+		Metadata:     h.Metadata(),
+	}, nil
+}
+
 func (o *object) ExpiringURL(expiresIn time.Duration) (*url.URL, error) {
+	return o.ExpiringURLAt(o.s3.now(), expiresIn)
+}
+
+func (o *object) ExpiringURLAt(start time.Time, expiresIn time.Duration) (*url.URL, error) {
+	return o.presign("GET", nil, nil, start, expiresIn)
+}
+
+// ResponseHeaders override headers of the response to a presigned GET
+// request. Empty fields are not overridden.
+type ResponseHeaders struct {
+	ContentType        string
+	ContentDisposition string
+	ContentEncoding    string
+	ContentLanguage    string
+	CacheControl       string
+	Expires            string
+}
+
+// query returns the response-* query parameters
+func (rh ResponseHeaders) query() url.Values {
+	q := make(url.Values)
+	for k, v := range map[string]string{
+		"response-content-type":        rh.ContentType,
+		"response-content-disposition": rh.ContentDisposition,
+		"response-content-encoding":    rh.ContentEncoding,
+		"response-content-language":    rh.ContentLanguage,
+		"response-cache-control":       rh.CacheControl,
+		"response-expires":             rh.Expires,
+	} {
+		if v != "" {
+			q.Set(k, v)
+		}
+	}
+	return q
+}
+
+func (o *object) ExpiringURLWithResponseHeaders(expiresIn time.Duration, rh ResponseHeaders) (*url.URL, error) {
+	return o.presign("GET", nil, rh.query(), o.s3.now(), expiresIn)
+}
+
+func (o *object) PresignPut(expiresIn time.Duration, contentType string) (*url.URL, error) {
+	h := make(http.Header)
+	if contentType != "" {
+		h.Set("Content-Type", contentType)
+	}
+	return o.presign("PUT", h, nil, o.s3.now(), expiresIn)
+}
+
+// presign returns a signed URL for a request with the specified method,
+// header and query parameters, valid for expiresIn from start. The request
+// must be sent with the same header.
+func (o *object) presign(method string, h http.Header, query url.Values, start time.Time, expiresIn time.Duration) (*url.URL, error) {
+	if err := o.s3.checkConfig(); err != nil {
+		return nil, err
+	}
+	o, err := o.resolve()
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(o.publicURL())
+	if err != nil {
+		return nil, err
+	}
+	if o.s3.signV4() {
+		u.RawQuery = query.Encode()
+		return u, o.s3.presignV4(method, u, h, start, expiresIn)
+	}
+
 	// create signature string
 	// TODO(erik): unify this with the request signing method.
-	method := "GET"
-	expires := strconv.FormatInt(time.Now().Add(expiresIn).Unix(), 10)
-	cres, _ := canonicalResource(o.resource(""), nil)
-	toSign := method + "\n\n\n" + expires + "\n" + cres
+	expires := strconv.FormatInt(start.Add(expiresIn).Unix(), 10)
+	cres := canonicalResource(o.resource(""), query)
+	amz := ""
+	if o.s3.Token != "" {
+		amz = "x-amz-security-token:" + o.s3.Token + "\n"
+	}
+	toSign := method + "\n" +
+		h.Get("Content-MD5") + "\n" +
+		h.Get("Content-Type") + "\n" +
+		expires + "\n" + amz + cres
 
 	// generate signature
 	mac := hmac.New(sha1.New, []byte(o.s3.Secret))
@@ -133,13 +737,14 @@ func (o *object) ExpiringURL(expiresIn time.Duration) (*url.URL, error) {
 
 	// assemble url
 	var v = make(url.Values)
+	for k, vv := range query {
+		v[k] = vv
+	}
 	v.Set("AWSAccessKeyId", o.s3.AccessKey)
 	v.Set("Expires", expires)
 	v.Set("Signature", sig)
-
-	u, err := url.Parse(o.url(""))
-	if err != nil {
-		return nil, err
+	if o.s3.Token != "" {
+		v.Set("x-amz-security-token", o.s3.Token)
 	}
 	u.RawQuery = v.Encode()
 
@@ -147,21 +752,15 @@ func (o *object) ExpiringURL(expiresIn time.Duration) (*url.URL, error) {
 }
 
 func (o *object) FormURL(acl ACL, policy Policy, query ...url.Values) (*url.URL, error) {
-	b, err := json.Marshal(policy)
+	form, err := o.FormUpload(acl, policy)
 	if err != nil {
 		return nil, err
 	}
 
-	policy64 := base64.StdEncoding.EncodeToString(b)
-	mac := hmac.New(sha1.New, []byte(o.s3.Secret))
-	mac.Write([]byte(policy64))
-
 	uv := make(url.Values)
-	uv.Set("AWSAccessKeyId", o.s3.AccessKey)
-	uv.Set("acl", string(acl))
-	uv.Set("key", o.Key())
-	uv.Set("signature", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
-	uv.Set("policy", policy64)
+	for k, v := range form.Fields {
+		uv.Set(k, v)
+	}
 	for _, p := range query {
 		for k, v := range p {
 			for _, v2 := range v {
@@ -170,7 +769,7 @@ func (o *object) FormURL(acl ACL, policy Policy, query ...url.Values) (*url.URL,
 		}
 	}
 
-	u, err := url.Parse(s3proto + `://` + o.s3.Bucket + `.` + o.hostWithRegion())
+	u, err := url.Parse(form.Action)
 	if err != nil {
 		return nil, err
 	}
@@ -179,41 +778,125 @@ func (o *object) FormURL(acl ACL, policy Policy, query ...url.Values) (*url.URL,
 	return u, nil
 }
 
-func (o *object) request(method string, code int, serr string) (*http.Response, error) {
-	req, err := http.NewRequest(method, o.url(""), nil)
+func (o *object) request(ctx context.Context, method string, code int, serr string) (*http.Response, error) {
+	req, err := o.newRequest(ctx, method, "", nil)
 	if err != nil {
 		return nil, err
 	}
+	return o.s3.send(req, code, serr)
+}
 
-	o.s3.signRequest(req)
+// getXML decodes the XML response to a GET request of the subresource query
+// into v. If S3 responds with the error code missing, v is left unchanged
+// and false is returned.
+func (o *object) getXML(query, missing string, v interface{}, serr string) (bool, error) {
+	req, err := o.newRequest(context.Background(), "GET", query, nil)
+	if err != nil {
+		return false, err
+	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := o.s3.send(req, 0, "")
 	if err != nil {
-		return nil, err
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if c := resp.StatusCode; c != 200 {
+		e := newS3Error(resp, "%s (%s)", serr, http.StatusText(c))
+		if c == 404 && e.Code == missing {
+			return false, nil
+		}
+		return false, e
+	}
+	return true, xml.NewDecoder(resp.Body).Decode(v)
+}
+
+// putXML sends v as XML with a PUT request of the subresource query, with
+// the additional header h, which may be nil
+func (o *object) putXML(query string, v interface{}, h http.Header, serr string) error {
+	b, err := xml.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	req, err := o.newRequest(context.Background(), "PUT", query, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	for k, vv := range h {
+		req.Header[k] = vv
 	}
+	req.Header.Set("Content-MD5", contentMD5(b))
 
-	if c := resp.StatusCode; code > 0 && c != code {
-		return nil, fmt.Errorf("s3: %s (%s)", serr, http.StatusText(c))
+	resp, err := o.s3.send(req, 200, serr)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (o *object) newRequest(ctx context.Context, method, query string, body io.Reader) (*http.Request, error) {
+	if err := o.s3.checkConfig(); err != nil {
+		return nil, err
 	}
+	req, err := http.NewRequestWithContext(ctx, method, o.url(query), body)
+	if err != nil {
+		return nil, err
+	}
+	for k, vv := range o.header {
+		req.Header[k] = append([]string(nil), vv...)
+	}
+	return req, nil
+}
 
-	return resp, nil
+func (o *object) WithHeader(key, value string) Object {
+	c := *o
+	c.header = o.header.Clone()
+	if c.header == nil {
+		c.header = make(http.Header)
+	}
+	c.header.Add(key, value)
+	return &c
 }
 
 func (o *object) resource(query string) string {
 	return `/` + o.s3.Bucket + `/` + o.Key() + query
 }
 
+// url returns the request URL. The key is escaped the same way as in the
+// signed canonical resource, so keys with spaces, plus signs or reserved
+// characters are signed and sent consistently.
 func (o *object) url(query string) string {
-	return s3proto + `://` + o.hostWithRegion() + o.resource(query)
+	return o.s3.bucketURL() + `/` + escapePath(o.Key()) + query
 }
 
-func (o *object) hostWithRegion() string {
-	if o.s3.Region == "" {
-		return s3servicehost + `.` + s3awshost
-	}
-	return s3servicehost + `-` + o.s3.Region + `.` + s3awshost
+// publicURL returns the URL of generated links to the object
+func (o *object) publicURL() string {
+	return o.s3.publicURL() + `/` + escapePath(o.Key())
 }
 
 func trim(s string) string {
 	return strings.Trim(s, ` /`)
 }
+
+// ctxReader reports the context error if a read fails because the context
+// was cancelled mid-transfer.
+type ctxReader struct {
+	ctx context.Context
+	rc  io.ReadCloser
+}
+
+func (r *ctxReader) Read(p []byte) (int, error) {
+	n, err := r.rc.Read(p)
+	if err != nil && err != io.EOF {
+		if cerr := r.ctx.Err(); cerr != nil {
+			err = cerr
+		}
+	}
+	return n, err
+}
+
+func (r *ctxReader) Close() error {
+	return r.rc.Close()
+}
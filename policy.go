@@ -9,9 +9,62 @@ type (
 	policyMap map[string]interface{}
 )
 
+// policyTimeFormat is the ISO 8601 format of the policy expiration
+const policyTimeFormat = "2006-01-02T15:04:05Z"
+
+// NewPolicy returns a POST policy which expires after expiresIn and contains
+// the conditions
+func NewPolicy(expiresIn time.Duration, conditions ...Condition) Policy {
+	p := make(Policy)
+	p["expiration"] = now().UTC().Add(expiresIn).Format(policyTimeFormat)
+	c := p.Conditions()
+	for _, v := range conditions {
+		*c = append(*c, v.v)
+	}
+	return p
+}
+
+// Condition is a condition of a POST policy
+type Condition struct {
+	v interface{}
+}
+
+// Equals requires the form field to have the value. Fields other than bucket
+// and acl start with "$", e.g. "$key".
+func Equals(field, value string) Condition {
+	return Condition{[]interface{}{"eq", field, value}}
+}
+
+// StartsWith requires the form field to start with prefix. An empty prefix
+// allows any value.
+func StartsWith(field, prefix string) Condition {
+	return Condition{[]interface{}{"starts-with", field, prefix}}
+}
+
+// ContentLengthRange limits the size of the uploaded file to min through max
+// bytes
+func ContentLengthRange(min, max int64) Condition {
+	return Condition{[]interface{}{"content-length-range", min, max}}
+}
+
+// BucketEquals requires the upload to go to bucket
+func BucketEquals(bucket string) Condition {
+	return Condition{map[string]string{"bucket": bucket}}
+}
+
+// ACLEquals requires the uploaded object to have the canned ACL
+func ACLEquals(acl ACL) Condition {
+	return Condition{map[string]string{"acl": string(acl)}}
+}
+
+// KeyStartsWith requires the object key to start with prefix
+func KeyStartsWith(prefix string) Condition {
+	return StartsWith("$key", prefix)
+}
+
 func (p Policy) SetExpiration(seconds uint) {
 	exp := time.Now().UTC().Add(time.Second * time.Duration(seconds))
-	p["expiration"] = exp.Format("2006-01-02T15:04:05Z")
+	p["expiration"] = exp.Format(policyTimeFormat)
 }
 
 func (p Policy) Conditions() *PolicyConditions {
@@ -0,0 +1,53 @@
+package s3
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNewPolicy(t *testing.T) {
+	defer func(f func() time.Time) { now = f }(now)
+	now = func() time.Time {
+		return time.Date(2015, 12, 30, 13, 0, 0, 0, time.FixedZone("CET", 3600))
+	}
+
+	p := NewPolicy(time.Hour,
+		BucketEquals("bucket"),
+		ACLEquals(PublicRead),
+		KeyStartsWith("user/"),
+		Equals("$Content-Type", "image/png"),
+		StartsWith("$x-amz-meta-tag", ""),
+		ContentLengthRange(1, 10<<20),
+	)
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"conditions":[` +
+		`{"bucket":"bucket"},` +
+		`{"acl":"public-read"},` +
+		`["starts-with","$key","user/"],` +
+		`["eq","$Content-Type","image/png"],` +
+		`["starts-with","$x-amz-meta-tag",""],` +
+		`["content-length-range",1,10485760]` +
+		`],"expiration":"2015-12-30T13:00:00Z"}`
+	if x := string(b); x != expected {
+		t.Fatal(x)
+	}
+
+	// conditions can still be added
+	p.Conditions().SuccessActionRedirect("http://example.com/")
+	if x := len(*p.Conditions()); x != 7 {
+		t.Fatal(x)
+	}
+
+	// no conditions
+	b, err = json.Marshal(NewPolicy(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := string(b); x != `{"conditions":[],"expiration":"2015-12-30T12:01:00Z"}` {
+		t.Fatal(x)
+	}
+}
@@ -0,0 +1,43 @@
+package s3
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Preconditions make a delete or an upload conditional, e.g. to replace an
+// object only if it wasn't changed since it was read. If they are not met,
+// ErrPreconditionFailed is returned. Zero fields are ignored.
+type Preconditions struct {
+	// IfMatch requires the object to have the ETag
+	IfMatch string
+
+	// IfNoneMatch "*" requires the object not to exist. Uploads only.
+	IfNoneMatch string
+
+	// IfUnmodifiedSince requires the object not to be modified since the
+	// time
+	IfUnmodifiedSince time.Time
+
+	// IfMatchLastModified requires the object to be last modified at exactly
+	// the time. It is sent as x-amz-if-match-last-modified-time. Deletes
+	// only.
+	IfMatchLastModified time.Time
+}
+
+// setHeaders sets the conditional request headers
+func (p *Preconditions) setHeaders(h http.Header) {
+	if v := p.IfMatch; v != "" {
+		h.Set("If-Match", `"`+strings.Trim(v, `"`)+`"`)
+	}
+	if v := p.IfNoneMatch; v != "" {
+		h.Set("If-None-Match", v)
+	}
+	if t := p.IfUnmodifiedSince; !t.IsZero() {
+		h.Set("If-Unmodified-Since", t.UTC().Format(http.TimeFormat))
+	}
+	if t := p.IfMatchLastModified; !t.IsZero() {
+		h.Set("X-Amz-If-Match-Last-Modified-Time", t.UTC().Format(http.TimeFormat))
+	}
+}
@@ -0,0 +1,73 @@
+package s3
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPreconditions(t *testing.T) {
+	s3, f := newFakeS3(t)
+	var signed []string
+	s3.DebugSignature = func(req *http.Request, stringToSign string) {
+		signed = append(signed, stringToSign)
+	}
+	f.put("/key", []byte("data"), nil)
+	etag := md5ETag([]byte("data"))
+
+	// uploads
+	err := s3.Object("key").Put(strings.NewReader("new"), 3, WithPreconditions(Preconditions{IfNoneMatch: "*"}))
+	if err != ErrPreconditionFailed {
+		t.Fatal(err)
+	}
+	err = s3.Object("key").Put(strings.NewReader("new"), 3, WithPreconditions(Preconditions{IfMatch: "other"}))
+	if err != ErrPreconditionFailed {
+		t.Fatal(err)
+	}
+	w := s3.Object("key").WriterWithOptions(UploadOptions{
+		SinglePutThreshold: -1,
+		Preconditions:      Preconditions{IfMatch: "other"},
+	})
+	w.Write([]byte("new"))
+	if err := w.Close(); err != ErrPreconditionFailed {
+		t.Fatal(err)
+	}
+	if len(f.uploads) != 0 {
+		t.Fatal("upload was not aborted")
+	}
+	if string(f.get("/key").body) != "data" {
+		t.Fatal("object was replaced")
+	}
+
+	err = s3.Object("key").Put(strings.NewReader("new"), 3, WithPreconditions(Preconditions{IfMatch: etag}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	etag = md5ETag([]byte("new"))
+
+	// deletes
+	if err := s3.Object("key").DeleteIf(Preconditions{IfMatch: "other"}); err != ErrPreconditionFailed {
+		t.Fatal(err)
+	}
+	lastModified := time.Date(2009, 10, 12, 17, 50, 0, 0, time.UTC)
+	signed = nil
+	err = s3.Object("key").DeleteIf(Preconditions{
+		IfMatch:             etag,
+		IfUnmodifiedSince:   lastModified,
+		IfMatchLastModified: lastModified,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := f.lastHeader()
+	if h.Get("If-Match") != `"`+etag+`"` || h.Get("If-Unmodified-Since") != "Mon, 12 Oct 2009 17:50:00 GMT" {
+		t.Fatal(h)
+	}
+	if len(signed) != 1 || !strings.Contains(signed[0], "\nx-amz-if-match-last-modified-time:Mon, 12 Oct 2009 17:50:00 GMT\n") {
+		t.Fatal(signed)
+	}
+	if f.get("/key") != nil {
+		t.Fatal("not deleted")
+	}
+}
@@ -0,0 +1,83 @@
+package s3
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+// ProgressFunc is called periodically during a transfer with the number of
+// bytes transferred so far and the total number of bytes, or -1 if the total
+// is unknown.
+type ProgressFunc func(transferred, total int64)
+
+// progressInterval is the minimum time between two progress calls
+var progressInterval = 100 * time.Millisecond
+
+// progress throttles the calls of a ProgressFunc. A nil *progress ignores all
+// calls.
+type progress struct {
+	mu       sync.Mutex
+	fn       ProgressFunc
+	total    int64
+	n        int64
+	reported int64
+	last     time.Time
+}
+
+func newProgress(fn ProgressFunc, total int64) *progress {
+	if fn == nil {
+		return nil
+	}
+	return &progress{fn: fn, total: total, reported: -1}
+}
+
+// add adds n transferred bytes and reports them if the interval has passed
+func (p *progress) add(n int64) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.n += n
+	if t := time.Now(); t.Sub(p.last) >= progressInterval {
+		p.last = t
+		p.report()
+	}
+}
+
+// done reports the final count if it wasn't reported yet
+func (p *progress) done() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.report()
+}
+
+func (p *progress) report() {
+	if p.n != p.reported {
+		p.reported = p.n
+		p.fn(p.n, p.total)
+	}
+}
+
+// progressReader reports the bytes read from rc
+type progressReader struct {
+	rc io.ReadCloser
+	p  *progress
+}
+
+func (r *progressReader) Read(b []byte) (int, error) {
+	n, err := r.rc.Read(b)
+	r.p.add(int64(n))
+	if err == io.EOF {
+		r.p.done()
+	}
+	return n, err
+}
+
+func (r *progressReader) Close() error {
+	return r.rc.Close()
+}
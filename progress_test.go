@@ -0,0 +1,111 @@
+package s3
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+)
+
+// countingReader counts the bytes read from r
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+type progressRecorder struct {
+	mu    sync.Mutex
+	calls [][2]int64
+}
+
+func (r *progressRecorder) fn(n, total int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, [2]int64{n, total})
+}
+
+func (r *progressRecorder) check(t *testing.T, n, total int64) {
+	t.Helper()
+	if len(r.calls) == 0 {
+		t.Fatal("no progress")
+	}
+	for i, c := range r.calls {
+		if i > 0 && c[0] <= r.calls[i-1][0] {
+			t.Fatal(r.calls)
+		}
+		if c[1] != total {
+			t.Fatal(c)
+		}
+	}
+	if x := r.calls[len(r.calls)-1][0]; x != n {
+		t.Fatal(x)
+	}
+}
+
+func TestUploadProgress(t *testing.T) {
+	defer func(d time.Duration) { progressInterval = d }(progressInterval)
+	progressInterval = 0
+
+	s3, _ := newFakeS3(t)
+	data := bytes.Repeat([]byte("0123456789"), (2*MinPartSize+MinPartSize/2)/10)
+
+	for _, size := range []int64{0, int64(len(data))} {
+		var rec progressRecorder
+		r := &countingReader{r: bytes.NewReader(data)}
+		w := s3.Object("key").WriterWithOptions(UploadOptions{Progress: rec.fn, Size: size})
+		if _, err := io.CopyBuffer(w, r, make([]byte, 1024)); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		total := size
+		if total == 0 {
+			total = -1
+		}
+		rec.check(t, r.n, total)
+		if len(rec.calls) != 3 {
+			t.Fatal(rec.calls)
+		}
+	}
+}
+
+func TestDownloadProgress(t *testing.T) {
+	defer func(d time.Duration) { progressInterval = d }(progressInterval)
+
+	s3, f := newFakeS3(t)
+	data := bytes.Repeat([]byte("0123456789"), 10000)
+	f.put("/key", data, nil)
+
+	for _, v := range []struct {
+		interval time.Duration
+		calls    int
+	}{
+		{0, 0},
+		{time.Hour, 2},
+	} {
+		progressInterval = v.interval
+		var rec progressRecorder
+		rc, _, err := s3.Object("key").ReaderWithProgress(rec.fn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := &countingReader{r: rc}
+		if _, err := io.Copy(ioutil.Discard, r); err != nil {
+			t.Fatal(err)
+		}
+		rc.Close()
+		rec.check(t, r.n, int64(len(data)))
+		if v.calls > 0 && len(rec.calls) != v.calls {
+			t.Fatal(rec.calls)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+)
+
+// PutOption configures an upload done with Put
+type PutOption func(*UploadOptions)
+
+// WithUploadOptions applies all of opts to the upload
+func WithUploadOptions(opts UploadOptions) PutOption {
+	return func(o *UploadOptions) {
+		*o = opts
+	}
+}
+
+// WithACL sets the canned ACL of the uploaded object
+func WithACL(acl ACL) PutOption {
+	return func(o *UploadOptions) {
+		o.ACL = acl
+	}
+}
+
+// WithContentType sets the content type of the uploaded object
+func WithContentType(contentType string) PutOption {
+	return func(o *UploadOptions) {
+		o.ContentType = contentType
+	}
+}
+
+// WithContentEncoding sets the Content-Encoding header of the uploaded
+// object, e.g. for data that is already compressed
+func WithContentEncoding(encoding string) PutOption {
+	return func(o *UploadOptions) {
+		o.ContentEncoding = encoding
+	}
+}
+
+// WithEncryption requests server-side encryption of the uploaded object. The
+// KMS key id is only used with EncryptionKMS and may be empty to use the
+// default key.
+func WithEncryption(enc Encryption, kmsKeyId string) PutOption {
+	return func(o *UploadOptions) {
+		o.Encryption = enc
+		o.KMSKeyId = kmsKeyId
+	}
+}
+
+// WithMetadata sets the user metadata of the uploaded object
+func WithMetadata(m map[string]string) PutOption {
+	return func(o *UploadOptions) {
+		o.Metadata = m
+	}
+}
+
+// WithTags sets the tag set of the uploaded object
+func WithTags(tags map[string]string) PutOption {
+	return func(o *UploadOptions) {
+		o.Tags = tags
+	}
+}
+
+// WithChecksum adds a checksum of the uploaded data with the specified
+// algorithm, which is verified by S3
+func WithChecksum(a ChecksumAlgorithm) PutOption {
+	return func(o *UploadOptions) {
+		o.Checksum = a
+	}
+}
+
+// WithStorageClass sets the storage class of the uploaded object
+func WithStorageClass(class StorageClass) PutOption {
+	return func(o *UploadOptions) {
+		o.StorageClass = class
+	}
+}
+
+// WithPreconditions makes the upload conditional
+func WithPreconditions(p Preconditions) PutOption {
+	return func(o *UploadOptions) {
+		o.Preconditions = p
+	}
+}
+
+func (o *object) Put(r io.Reader, size int64, opts ...PutOption) error {
+	var uo UploadOptions
+	for _, opt := range opts {
+		opt(&uo)
+	}
+
+	b, err := readSize(r, size)
+	if err != nil {
+		return err
+	}
+	if uo.Gzip {
+		if uo.ContentType == "" {
+			uo.ContentType = uo.detectContentType(o.key, b)
+		}
+		if b, err = gzipBytes(b); err != nil {
+			return err
+		}
+	}
+	return o.put(context.Background(), b, uo)
+}
+
+// put uploads b with a single request
+func (o *object) put(ctx context.Context, b []byte, uo UploadOptions) error {
+	req, err := o.newRequest(ctx, "PUT", "", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	uo.setHeaders(req.Header, o.key, b)
+	newRateLimiter(uo.RateLimit).limitBody(req)
+	uo.Preconditions.setHeaders(req.Header)
+
+	req.Header.Set("Content-MD5", contentMD5(b))
+	if a := uo.Checksum; a != "" {
+		req.Header.Set(a.header(), a.checksum(b))
+	}
+
+	resp, err := o.s3.send(req, 200, "error putting object")
+	if err != nil {
+		return preconditionError(err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// readSize reads exactly size bytes from r, or everything if size is
+// negative
+func readSize(r io.Reader, size int64) ([]byte, error) {
+	if size < 0 {
+		return ioutil.ReadAll(r)
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// contentMD5 returns the base64 encoded MD5 of b for the Content-MD5 header
+func contentMD5(b []byte) string {
+	sum := md5.Sum(b)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
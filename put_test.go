@@ -0,0 +1,113 @@
+package s3
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// unknownLength hides the length of a reader
+type unknownLength struct {
+	io.Reader
+}
+
+func TestPut(t *testing.T) {
+	s3, f := newFakeS3(t)
+	o := s3.Object("hello.txt")
+
+	// known length
+	err := o.Put(strings.NewReader("hello world"), 11, WithACL(PublicRead))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := f.lastHeader()
+	if x := h.Get("Content-Md5"); x != "XrY7u+Ae7tCTyyK7j1rNww==" {
+		t.Fatal(x)
+	}
+	if x := h.Get("Content-Length"); x != "11" {
+		t.Fatal(x)
+	}
+	if x := h.Get("X-Amz-Acl"); x != "public-read" {
+		t.Fatal(x)
+	}
+	obj := f.get("/hello.txt")
+	if x := string(obj.body); x != "hello world" {
+		t.Fatal(x)
+	}
+	if x := obj.header.Get("Content-Type"); x != "text/plain" {
+		t.Fatal(x)
+	}
+
+	// unknown length
+	err = o.Put(unknownLength{bytes.NewBufferString("hello again")}, -1, WithContentType("text/x-hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := f.lastHeader().Get("Content-Length"); x != "11" {
+		t.Fatal(x)
+	}
+	obj = f.get("/hello.txt")
+	if x := string(obj.body); x != "hello again" {
+		t.Fatal(x)
+	}
+	if x := obj.header.Get("Content-Type"); x != "text/x-hello" {
+		t.Fatal(x)
+	}
+
+	// short body
+	if err := o.Put(strings.NewReader("short"), 10); err != io.ErrUnexpectedEOF {
+		t.Fatal(err)
+	}
+	if x := f.count("PUT"); x != 2 {
+		t.Fatal(x)
+	}
+}
+
+// corruptTransport flips the first byte of request bodies after signing
+type corruptTransport struct {
+	http.RoundTripper
+}
+
+func (t corruptTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) > 0 {
+			b[0] ^= 0xff
+		}
+		req = req.Clone(req.Context())
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+	return t.RoundTripper.RoundTrip(req)
+}
+
+func TestPutBadDigest(t *testing.T) {
+	s3, f := newFakeS3(t)
+	s3.Client.Transport = corruptTransport{s3.Client.Transport}
+
+	err := s3.Object("key").Put(strings.NewReader("hello world"), 11)
+	if e, ok := err.(*S3Error); !ok || e.Code != "BadDigest" {
+		t.Fatal(err)
+	}
+	if f.get("/key") != nil {
+		t.Fatal("corrupted object stored")
+	}
+
+	// multipart parts
+	w := s3.Object("key").WriterWithOptions(UploadOptions{SinglePutThreshold: -1})
+	if _, err := w.Write([]byte("hello world")); err != nil {
+		t.Fatal(err)
+	}
+	err = w.Close()
+	if e, ok := err.(*S3Error); !ok || e.Code != "BadDigest" {
+		t.Fatal(err)
+	}
+	if f.get("/key") != nil {
+		t.Fatal("corrupted object stored")
+	}
+}
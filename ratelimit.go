@@ -0,0 +1,109 @@
+package s3
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket that allows rate bytes per second, with
+// bursts of up to one second. It starts empty. A nil *rateLimiter doesn't
+// limit.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(bytesPerSec int64) *rateLimiter {
+	if bytesPerSec <= 0 {
+		return nil
+	}
+	return &rateLimiter{rate: float64(bytesPerSec), last: time.Now()}
+}
+
+// wait takes n tokens and blocks until they are available or ctx is done.
+// Tokens may be taken in advance, so concurrent callers wait in turn.
+func (l *rateLimiter) wait(ctx context.Context, n int) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	t := time.Now()
+	l.tokens += t.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.rate {
+		l.tokens = l.rate
+	}
+	l.last = t
+	l.tokens -= float64(n)
+	d := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if d <= 0 {
+		return nil
+	}
+	return sleep(ctx, d)
+}
+
+// reader returns rc limited by l
+func (l *rateLimiter) reader(ctx context.Context, rc io.ReadCloser) io.ReadCloser {
+	if l == nil {
+		return rc
+	}
+	return &rateLimitReader{ctx: ctx, rc: rc, l: l}
+}
+
+// limitBody limits the body of req, including the bodies of retries
+func (l *rateLimiter) limitBody(req *http.Request) {
+	if l == nil || req.Body == nil || req.Body == http.NoBody {
+		return
+	}
+	ctx := req.Context()
+	req.Body = l.reader(ctx, req.Body)
+	if getBody := req.GetBody; getBody != nil {
+		req.GetBody = func() (io.ReadCloser, error) {
+			rc, err := getBody()
+			if err != nil {
+				return nil, err
+			}
+			return l.reader(ctx, rc), nil
+		}
+	}
+}
+
+// rateLimitReader reads from rc no faster than l allows
+type rateLimitReader struct {
+	ctx context.Context
+	rc  io.ReadCloser
+	l   *rateLimiter
+}
+
+func (r *rateLimitReader) Read(p []byte) (int, error) {
+	// read at most a tenth of a second worth of data at once to keep the
+	// rate smooth
+	if max := int(r.l.rate / 10); max > 0 && len(p) > max {
+		p = p[:max]
+	}
+	n, err := r.rc.Read(p)
+	if n > 0 {
+		if werr := r.l.wait(r.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
+
+func (r *rateLimitReader) Close() error {
+	return r.rc.Close()
+}
+
+func (o *object) ReaderWithRateLimit(ctx context.Context, bytesPerSec int64) (io.ReadCloser, http.Header, error) {
+	rc, h, err := o.ReaderContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return newRateLimiter(bytesPerSec).reader(ctx, rc), h, nil
+}
@@ -0,0 +1,58 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestRateLimit(t *testing.T) {
+	s3, f := newFakeS3(t)
+	data := bytes.Repeat([]byte("x"), 50<<10)
+
+	// 50 KiB at 100 KiB/s take at least half a second
+	start := time.Now()
+	w := s3.Object("key").WriterWithOptions(UploadOptions{RateLimit: 100 << 10})
+	w.Write(data)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d < 500*time.Millisecond {
+		t.Fatal("upload took", d)
+	}
+	if !bytes.Equal(f.get("/key").body, data) {
+		t.Fatal("data differs")
+	}
+
+	start = time.Now()
+	r, _, err := s3.Object("key").ReaderWithRateLimit(context.Background(), 100<<10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil || !bytes.Equal(b, data) {
+		t.Fatal(len(b), err)
+	}
+	if d := time.Since(start); d < 500*time.Millisecond {
+		t.Fatal("download took", d)
+	}
+
+	// cancellation stops waiting
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start = time.Now()
+	r, _, err = s3.Object("key").ReaderWithRateLimit(ctx, 1<<10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if _, err := ioutil.ReadAll(r); err != context.DeadlineExceeded {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Fatal("read took", d)
+	}
+}
@@ -0,0 +1,152 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// ObjectReaderAt reads an object at arbitrary offsets with ranged GET
+// requests. All reads are done from the version of the object that existed
+// when it was created; ReadAt fails with ErrObjectChanged if it was replaced.
+// It is safe for concurrent use.
+type ObjectReaderAt struct {
+	// CacheBlockSize and CacheBlocks enable caching of recently read data.
+	// Reads are then done in blocks of CacheBlockSize bytes, of which the
+	// last CacheBlocks are kept. They must be set before the first ReadAt.
+	CacheBlockSize int64
+	CacheBlocks    int
+
+	o     *object
+	size  int64
+	etag  string
+	mu    sync.Mutex
+	cache []*cachedBlock
+}
+
+// cachedBlock is the data of the block with the index
+type cachedBlock struct {
+	index int64
+	data  []byte
+}
+
+func (o *object) ReaderAt() (*ObjectReaderAt, error) {
+	h, err := o.statHead(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	size, err := h.ContentLength()
+	if err != nil {
+		return nil, fmt.Errorf("s3: invalid Content-Length: %v", err)
+	}
+	return &ObjectReaderAt{o: o, size: size, etag: h.ETag()}, nil
+}
+
+// Size returns the size of the object
+func (r *ObjectReaderAt) Size() int64 {
+	return r.size
+}
+
+// ReadAt reads len(p) bytes at offset off. Like io.ReaderAt requires, it
+// returns io.EOF if fewer bytes are available.
+func (r *ObjectReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("s3: negative offset")
+	}
+	if off >= r.size {
+		return 0, io.EOF
+	}
+	var eof error
+	if rem := r.size - off; int64(len(p)) > rem {
+		p = p[:rem]
+		eof = io.EOF
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	var err error
+	if r.CacheBlockSize > 0 && r.CacheBlocks > 0 {
+		err = r.readCached(p, off)
+	} else {
+		err = r.read(p, off)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return len(p), eof
+}
+
+// read reads p at off with a single request
+func (r *ObjectReaderAt) read(p []byte, off int64) error {
+	rc, _, err := r.o.readerRange(context.Background(), r.etag, off, off+int64(len(p))-1)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	if _, err := io.ReadFull(rc, p); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return nil
+}
+
+// readCached reads p at off from cached blocks
+func (r *ObjectReaderAt) readCached(p []byte, off int64) error {
+	bs := r.CacheBlockSize
+	for len(p) > 0 {
+		i := off / bs
+		b, err := r.block(i)
+		if err != nil {
+			return err
+		}
+		n := copy(p, b[off-i*bs:])
+		p = p[n:]
+		off += int64(n)
+	}
+	return nil
+}
+
+// block returns the data of block i, from the cache if possible
+func (r *ObjectReaderAt) block(i int64) ([]byte, error) {
+	if b := r.cached(i); b != nil {
+		return b, nil
+	}
+
+	start := i * r.CacheBlockSize
+	end := start + r.CacheBlockSize
+	if end > r.size {
+		end = r.size
+	}
+	b := make([]byte, end-start)
+	if err := r.read(b, start); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache = append([]*cachedBlock{{index: i, data: b}}, r.cache...)
+	if len(r.cache) > r.CacheBlocks {
+		r.cache = r.cache[:r.CacheBlocks]
+	}
+	return b, nil
+}
+
+// cached returns the data of block i and moves it to the front of the
+// cache, or nil if it isn't cached
+func (r *ObjectReaderAt) cached(i int64) []byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for j, b := range r.cache {
+		if b.index == i {
+			copy(r.cache[1:j+1], r.cache[:j])
+			r.cache[0] = b
+			return b.data
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package s3
+
+import (
+	"io"
+	"testing"
+)
+
+func TestReaderAt(t *testing.T) {
+	s3, f := newFakeS3(t)
+	f.put("/key", []byte("0123456789abcdefghij"), nil)
+
+	r, err := s3.Object("key").ReaderAt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Size() != 20 {
+		t.Fatal(r.Size())
+	}
+
+	for _, tt := range []struct {
+		off  int64
+		n    int
+		want string
+		err  error
+	}{
+		{0, 4, "0123", nil},
+		{8, 4, "89ab", nil},
+		{17, 3, "hij", nil},
+		{17, 5, "hij", io.EOF},
+		{20, 1, "", io.EOF},
+	} {
+		p := make([]byte, tt.n)
+		n, err := r.ReadAt(p, tt.off)
+		if err != tt.err || string(p[:n]) != tt.want {
+			t.Fatal(tt.off, string(p[:n]), err)
+		}
+	}
+	if _, err := r.ReadAt(make([]byte, 1), -1); err == nil {
+		t.Fatal("expected error")
+	}
+
+	// reads across block boundaries use the cache
+	r, err = s3.Object("key").ReaderAt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.CacheBlockSize = 8
+	r.CacheBlocks = 2
+	before := f.count("GET /key")
+	for _, tt := range []struct {
+		off  int64
+		want string
+		gets int
+	}{
+		{6, "6789", 2},
+		{4, "4567", 0},
+		{14, "efgh", 1},
+		{2, "23", 1},
+	} {
+		p := make([]byte, len(tt.want))
+		if _, err := r.ReadAt(p, tt.off); err != nil || string(p) != tt.want {
+			t.Fatal(tt.off, string(p), err)
+		}
+		if n := f.count("GET /key"); n-before != tt.gets {
+			t.Fatal(tt.off, n-before)
+		}
+		before = f.count("GET /key")
+	}
+
+	// block 1 was evicted and is read again
+	f.put("/key", []byte("replaced"), nil)
+	if _, err := r.ReadAt(make([]byte, 4), 9); err != ErrObjectChanged {
+		t.Fatal(err)
+	}
+}
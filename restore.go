@@ -0,0 +1,68 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/http"
+)
+
+// RestoreTier is the retrieval tier of a restore from an archive storage
+// class
+type RestoreTier string
+
+const (
+	TierStandard  RestoreTier = "Standard"
+	TierExpedited RestoreTier = "Expedited"
+	TierBulk      RestoreTier = "Bulk"
+)
+
+// ErrRestoreInProgress is returned by Restore if a restore of the object is
+// already in progress
+var ErrRestoreInProgress = errors.New("s3: restore already in progress")
+
+type restoreRequest struct {
+	XMLName              xml.Name `xml:"RestoreRequest"`
+	Days                 int
+	GlacierJobParameters *struct {
+		Tier RestoreTier
+	} `xml:",omitempty"`
+}
+
+func (o *object) Restore(days int, tier RestoreTier) error {
+	r := restoreRequest{Days: days}
+	if tier != "" {
+		r.GlacierJobParameters = &struct{ Tier RestoreTier }{tier}
+	}
+	b, err := xml.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	req, err := o.newRequest(context.Background(), "POST", "?restore", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-MD5", contentMD5(b))
+
+	resp, err := o.s3.send(req, 0, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case 202:
+		// restore started
+		return nil
+	case 200:
+		// already restored, the expiry was updated
+		return nil
+	}
+	e := newS3Error(resp, "error restoring object (%s)", http.StatusText(resp.StatusCode))
+	if e.Code == "RestoreAlreadyInProgress" {
+		return ErrRestoreInProgress
+	}
+	return e
+}
@@ -0,0 +1,56 @@
+package s3
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestRestore(t *testing.T) {
+	var body string
+	status := 202
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if x := r.Method + " " + r.URL.RequestURI(); x != "POST /key?restore" {
+			t.Error(x)
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		w.WriteHeader(status)
+		if status == 409 {
+			w.Write([]byte(`<Error><Code>RestoreAlreadyInProgress</Code><Message>Object restore is already in progress</Message></Error>`))
+		}
+	}))
+	o := s3.Object("key")
+
+	for _, v := range []struct {
+		days int
+		tier RestoreTier
+		body string
+	}{
+		{7, TierExpedited, `<RestoreRequest><Days>7</Days><GlacierJobParameters><Tier>Expedited</Tier></GlacierJobParameters></RestoreRequest>`},
+		{1, TierBulk, `<RestoreRequest><Days>1</Days><GlacierJobParameters><Tier>Bulk</Tier></GlacierJobParameters></RestoreRequest>`},
+		{2, "", `<RestoreRequest><Days>2</Days></RestoreRequest>`},
+	} {
+		if err := o.Restore(v.days, v.tier); err != nil {
+			t.Fatal(err)
+		}
+		if body != v.body {
+			t.Fatal(body)
+		}
+	}
+
+	status = 200
+	if err := o.Restore(1, TierStandard); err != nil {
+		t.Fatal(err)
+	}
+
+	status = 409
+	if err := o.Restore(1, TierStandard); err != ErrRestoreInProgress {
+		t.Fatal(err)
+	}
+
+	status = 403
+	if err, ok := o.Restore(1, TierStandard).(*S3Error); !ok || err.StatusCode != 403 {
+		t.Fatal(err)
+	}
+}
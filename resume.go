@@ -0,0 +1,68 @@
+package s3
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+func (o *object) ReaderResumable(ctx context.Context, maxResumes int) (io.ReadCloser, http.Header, error) {
+	rc, h, err := o.ReaderContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resumeReader{ctx: ctx, o: o, etag: Header(h).ETag(), rc: rc, max: maxResumes}, h, nil
+}
+
+// resumeReader continues reading with a ranged GET request from the current
+// offset if a read fails
+type resumeReader struct {
+	ctx     context.Context
+	o       *object
+	etag    string
+	rc      io.ReadCloser
+	off     int64
+	resumes int
+	max     int
+	err     error
+}
+
+func (r *resumeReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	for {
+		n, err := r.rc.Read(p)
+		r.off += int64(n)
+		if err == nil || err == io.EOF || r.ctx.Err() != nil || r.resumes >= r.max {
+			return n, err
+		}
+		if err := r.resume(); err != nil {
+			r.err = err
+			return n, err
+		}
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// resume replaces the failed response with a request for the rest of the
+// object. The ETag must not have changed.
+func (r *resumeReader) resume() error {
+	r.rc.Close()
+	if err := sleep(r.ctx, backoff(r.resumes)); err != nil {
+		return err
+	}
+	r.resumes++
+	rc, _, err := r.o.readerRange(r.ctx, r.etag, r.off, -1)
+	if err != nil {
+		return err
+	}
+	r.rc = rc
+	return nil
+}
+
+func (r *resumeReader) Close() error {
+	return r.rc.Close()
+}
@@ -0,0 +1,93 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// truncatingServer returns only the first half of the object to requests
+// without a Range header
+func truncatingServer(t *testing.T) (*S3, *fakeServer, *[]http.Header) {
+	f := newFakeServer()
+	var ranges []http.Header
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		o := f.get(r.URL.Path)
+		if r.Method != "GET" || o == nil || r.Header.Get("Range") != "" {
+			if r.Header.Get("Range") != "" {
+				ranges = append(ranges, r.Header.Clone())
+			}
+			f.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("ETag", o.header.Get("ETag"))
+		w.Header().Set("Content-Length", strconv.Itoa(len(o.body)))
+		w.Write(o.body[:len(o.body)/2])
+	}))
+	return s3, f, &ranges
+}
+
+func TestReaderResumable(t *testing.T) {
+	s3, f, ranges := truncatingServer(t)
+	data := bytes.Repeat([]byte("0123456789"), 1000)
+	f.put("/key", data, nil)
+
+	rc, _, err := s3.Object("key").ReaderResumable(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(rc)
+	rc.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, data) {
+		t.Fatal(len(b))
+	}
+	if len(*ranges) != 1 {
+		t.Fatal(len(*ranges))
+	}
+	h := (*ranges)[0]
+	if x := h.Get("Range"); x != "bytes=5000-" {
+		t.Fatal(x)
+	}
+	if x := h.Get("If-Match"); x != f.get("/key").header.Get("ETag") {
+		t.Fatal(x)
+	}
+
+	// without resumes the error is returned
+	rc, _, err = s3.Object("key").ReaderResumable(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(rc); err != io.ErrUnexpectedEOF {
+		t.Fatal(err)
+	}
+	rc.Close()
+}
+
+func TestReaderResumableChanged(t *testing.T) {
+	s3, f, _ := truncatingServer(t)
+	f.put("/key", bytes.Repeat([]byte("x"), 1000), nil)
+
+	rc, _, err := s3.Object("key").ReaderResumable(context.Background(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	b := make([]byte, 100)
+	if _, err := io.ReadFull(rc, b); err != nil {
+		t.Fatal(err)
+	}
+	f.put("/key", bytes.Repeat([]byte("y"), 1000), nil)
+	if _, err := ioutil.ReadAll(rc); err != ErrObjectChanged {
+		t.Fatal(err)
+	}
+	if _, err := rc.Read(b); err != ErrObjectChanged {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,67 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+var (
+	retryBaseDelay = 100 * time.Millisecond
+	retryMaxDelay  = 5 * time.Second
+)
+
+// retryable reports if a request that resulted in resp and err should be
+// retried
+func retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		// an attempt that exceeded Timeout is retried, but not a request
+		// whose own context is done
+		if errors.Is(err, errTimeout) {
+			return true
+		}
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	switch resp.StatusCode {
+	case 500, 503, 429:
+		return true
+	}
+	return false
+}
+
+// idempotent reports if req may be sent more than once. Of the POST
+// requests, only completing a multipart upload and multi-object deletes are
+// safe to repeat, unlike creating multipart uploads, restores and selects.
+func idempotent(req *http.Request) bool {
+	if req.Method != "POST" {
+		return true
+	}
+	q := req.URL.Query()
+	return q.Has("uploadId") || q.Has("delete")
+}
+
+// backoff returns the jittered delay before the retry following attempt
+func backoff(attempt int) time.Duration {
+	d := retryBaseDelay
+	for i := 0; i < attempt && d < retryMaxDelay; i++ {
+		d *= 2
+	}
+	if d > retryMaxDelay {
+		d = retryMaxDelay
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
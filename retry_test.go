@@ -0,0 +1,212 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	retryBaseDelay = time.Millisecond
+	defer func() { retryBaseDelay = 100 * time.Millisecond }()
+
+	attempts := 0
+	var bodies []string
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if attempts <= 2 {
+			w.WriteHeader(503)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	s3.MaxRetries = 2
+
+	// replayable body
+	if err := s3.Object("key").Put(strings.NewReader("body"), 4); err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 3 {
+		t.Fatal(attempts)
+	}
+	for _, b := range bodies {
+		if b != "body" {
+			t.Fatal(bodies)
+		}
+	}
+
+	// retries exhausted
+	attempts = 0
+	s3.MaxRetries = 1
+	err := s3.Object("key").Delete()
+	if e, ok := err.(*S3Error); !ok || e.StatusCode != 503 {
+		t.Fatal(err)
+	}
+	if attempts != 2 {
+		t.Fatal(attempts)
+	}
+
+	// a streaming body is not retried
+	attempts = 0
+	s3.MaxRetries = 2
+	req, err := http.NewRequest("PUT", s3.Object("key").(*object).url(""), ioutil.NopCloser(strings.NewReader("body")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s3.do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != 503 || attempts != 1 {
+		t.Fatal(resp.StatusCode, attempts)
+	}
+
+	// most POST requests are not idempotent
+	attempts = 0
+	err = s3.Object("key").Restore(1, TierStandard)
+	if e, ok := err.(*S3Error); !ok || e.StatusCode != 503 {
+		t.Fatal(err)
+	}
+	if attempts != 1 {
+		t.Fatal(attempts)
+	}
+
+	// but multi-object deletes are
+	attempts = 0
+	s3.DeleteMultiple([]string{"a", "b"})
+	if attempts != 3 {
+		t.Fatal(attempts)
+	}
+}
+
+func TestRetryComplete(t *testing.T) {
+	retryBaseDelay = time.Millisecond
+	defer func() { retryBaseDelay = 100 * time.Millisecond }()
+
+	f := newFakeServer()
+	failed := false
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// completing the upload fails once
+		if r.Method == "POST" && r.URL.Query().Has("uploadId") && !failed {
+			failed = true
+			w.WriteHeader(500)
+			return
+		}
+		f.ServeHTTP(w, r)
+	}))
+	s3.MaxRetries = 2
+
+	data := bytes.Repeat([]byte("a"), MinPartSize+1)
+	w := s3.Object("key").WriterWithOptions(UploadOptions{SinglePutThreshold: -1})
+	w.Write(data)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if x := f.count("POST /key?uploadId="); x != 1 || !failed {
+		t.Fatal(x, failed)
+	}
+	if x := f.count("DELETE"); x != 0 {
+		t.Fatal(x)
+	}
+	if o := f.get("/key"); o == nil || !bytes.Equal(o.body, data) {
+		t.Fatal("not completed")
+	}
+}
+
+func TestRetryContext(t *testing.T) {
+	attempts := 0
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(500)
+	}))
+	s3.MaxRetries = 5
+
+	// the deadline expires during the backoff
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := s3.Object("key").DeleteContext(ctx); err != context.DeadlineExceeded {
+		t.Fatal(err)
+	}
+	if attempts > 2 {
+		t.Fatal(attempts)
+	}
+
+	// wrapped context errors are not retried
+	err := &url.Error{Op: "Get", URL: "https://example.com", Err: context.Canceled}
+	if retryable(nil, err) {
+		t.Fatal(err)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		d := backoff(i)
+		max := retryMaxDelay
+		if i < 6 {
+			max = retryBaseDelay << uint(i)
+		}
+		if d < max/2 || d > max {
+			t.Fatal(i, d)
+		}
+	}
+}
+
+func TestRetryClockSkew(t *testing.T) {
+	defer func(f func() time.Time) { now = f }(now)
+	t0 := time.Date(2015, 1, 2, 3, 4, 5, 0, time.UTC)
+	now = func() time.Time { return t0 }
+	server := t0.Add(time.Hour)
+
+	var dates, bodies []string
+	reject := false
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		dates = append(dates, r.Header.Get("Date"))
+		bodies = append(bodies, string(b))
+		if reject || r.Header.Get("Date") != server.Format(http.TimeFormat) {
+			w.Header().Set("Date", server.Format(http.TimeFormat))
+			w.WriteHeader(403)
+			w.Write([]byte(`<Error><Code>RequestTimeTooSkewed</Code><Message>The difference between the request time and the current time is too large.</Message></Error>`))
+		}
+	}))
+
+	o := s3.Object("key")
+	if err := o.Put(strings.NewReader("data"), 4); err != nil {
+		t.Fatal(err)
+	}
+	if len(dates) != 2 || dates[1] != "Fri, 02 Jan 2015 04:04:05 GMT" || bodies[1] != "data" {
+		t.Fatal(dates, bodies)
+	}
+
+	// the skew is kept for later requests
+	if err := s3.Object("other").Put(strings.NewReader("data"), 4); err != nil {
+		t.Fatal(err)
+	}
+	if len(dates) != 3 {
+		t.Fatal(dates)
+	}
+
+	// only one retry
+	reject = true
+	err := o.Put(strings.NewReader("data"), 4)
+	if e, ok := err.(*S3Error); !ok || e.Code != "RequestTimeTooSkewed" || len(dates) != 5 {
+		t.Fatal(err, dates)
+	}
+
+	// the skew is per client
+	other := newTestS3(t, http.NotFoundHandler())
+	if x := other.now(); !x.Equal(t0) {
+		t.Fatal(x)
+	}
+	if x := s3.WithBucket("bucket2").now(); !x.Equal(server) {
+		t.Fatal(x)
+	}
+}
@@ -1,14 +1,21 @@
 package s3
 
 import (
+	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha1"
 	"encoding/base64"
+	"encoding/xml"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -17,94 +24,573 @@ type S3 struct {
 	// Bucket is the S3 bucket to use
 	Bucket string
 
+	// Region is the region of the bucket, e.g. "eu-west-1". If empty, the
+	// global endpoint is used with path-style URLs, as before regions were
+	// supported, and requests are signed for us-east-1.
 	Region string
 
-	// AccessKey is the S3 access key
+	// AccessKey is the S3 access key. If AccessKey and Secret are empty,
+	// requests are sent unsigned, which only works for reading public
+	// objects. Other operations fail with 403 Forbidden.
 	AccessKey string
 
 	// Secret is the S3 secret
 	Secret string
 
+	// Token is the optional session token of temporary security credentials
+	Token string
+
+	// Credentials provides the credentials before each request, if not
+	// nil, instead of AccessKey, Secret and Token, e.g. to use rotating
+	// temporary credentials. Providers that fetch credentials remotely
+	// should be wrapped with NewCredentialsCache.
+	Credentials CredentialsProvider
+
+	// AccessPointARN is the ARN of an access point to send requests to
+	// instead of the bucket, e.g.
+	// "arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point". Its
+	// region is used for signing, and requests are always signed with
+	// signature version 4. Endpoint and PathStyle are ignored.
+	AccessPointARN string
+
 	// Path is the path to prepend to all keys
 	Path string
+
+	// Endpoint is the URL of an S3 compatible service, e.g.
+	// "http://localhost:9000". If empty, the AWS endpoint of the region is used.
+	Endpoint string
+
+	// Insecure uses plain HTTP for endpoints and custom domains without a
+	// scheme, e.g. for local emulators. Signing is not affected.
+	Insecure bool
+
+	// PathStyle uses path-style URLs of the form <endpoint>/<bucket>/<key>
+	// instead of virtual-hosted style URLs.
+	PathStyle bool
+
+	// UseDualStack uses the dual-stack (IPv4 and IPv6) AWS endpoint of the
+	// region. It is ignored if Endpoint is set.
+	UseDualStack bool
+
+	// UseAccelerate sends requests through the S3 Transfer Acceleration
+	// endpoint, which must be enabled for the bucket. It can be combined
+	// with UseDualStack and is ignored if Endpoint is set. Bucket names with
+	// dots can't be accelerated.
+	UseAccelerate bool
+
+	// CustomDomain is the host of a CNAME pointing at the bucket, e.g.
+	// "assets.example.com", used for the URLs returned by ExpiringURL and
+	// FormURL. It may include a scheme, which defaults to https. Requests are
+	// still sent to the S3 endpoint.
+	CustomDomain string
+
+	// RequesterPays confirms that the requester pays for requests to a
+	// requester pays bucket
+	RequesterPays bool
+
+	// ExpectedBucketOwner is the account id of the expected bucket owner. If
+	// set, requests fail with AccessDenied if the bucket is owned by another
+	// account.
+	ExpectedBucketOwner string
+
+	// MaxRetries is the number of times a request is retried on connection
+	// errors and 500, 503 and 429 responses, with exponential backoff.
+	// Requests with a body that can't be replayed are not retried, nor are
+	// POST requests other than completing multipart uploads and
+	// multi-object deletes.
+	MaxRetries int
+
+	// Replicas are copies of the bucket, e.g. cross-region replicas, which
+	// GET and HEAD requests fall through to in order, if they fail with a
+	// connection error or a 5xx response after all retries
+	Replicas []Replica
+
+	// Timeout limits the time until the response header of a request is
+	// received, if the request context has no deadline. Reading the response
+	// body is not limited. Timed out attempts are retried up to MaxRetries
+	// times.
+	Timeout time.Duration
+
+	// UserAgent is appended to the User-Agent header of all requests, e.g.
+	// "myapp/1.2.3"
+	UserAgent string
+
+	// Logger is called around each HTTP request if not nil
+	Logger Logger
+
+	// DebugSignature is called with each signed request and the string that
+	// was signed for it, if not nil, to diagnose SignatureDoesNotMatch
+	// errors. The secret is not part of the string to sign.
+	DebugSignature func(req *http.Request, stringToSign string)
+
+	// Client is the HTTP client used to send requests. If nil,
+	// http.DefaultClient is used.
+	Client *http.Client
+
+	// SignatureVersion is the request signing version to use, either 2 or 4.
+	// Defaults to 2 if not set.
+	SignatureVersion int
+
+	skew *int64
+}
+
+// libraryUserAgent identifies the package in the User-Agent header
+const libraryUserAgent = "go-s3"
+
+// userAgent returns the User-Agent header of requests
+func (s3 *S3) userAgent() string {
+	if s3.UserAgent == "" {
+		return libraryUserAgent
+	}
+	return libraryUserAgent + " " + s3.UserAgent
+}
+
+// now returns the current time. It is replaced in tests.
+var now = time.Now
+
+// skewMu guards the allocation of the clock skew of configurations
+var skewMu sync.Mutex
+
+// clockSkew returns the offset of the S3 clock from the local clock in
+// nanoseconds, learned from RequestTimeTooSkewed errors. It is allocated on
+// first use and shared by the copies of s3 in objects, buckets and replicas,
+// so it must be called before s3 is copied. It is accessed atomically.
+func (s3 *S3) clockSkew() *int64 {
+	skewMu.Lock()
+	defer skewMu.Unlock()
+	if s3.skew == nil {
+		s3.skew = new(int64)
+	}
+	return s3.skew
+}
+
+// now returns the current time, corrected by the learned clock skew
+func (s3 *S3) now() time.Time {
+	return now().Add(time.Duration(atomic.LoadInt64(s3.clockSkew())))
 }
 
 func (s3 *S3) Object(key string) Object {
+	s3.clockSkew()
 	return &object{key: key, s3: *s3}
 }
 
-// http://docs.aws.amazon.com/AmazonS3/latest/dev/RESTAuthentication.html
-func (s3 *S3) authString(req *http.Request) string {
-	if req.Header.Get("Date") == "" {
-		req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+// ObjectRaw returns the object with exactly the specified key. Unlike Object,
+// leading and trailing slashes are preserved, e.g. for "folder/" directory
+// markers, and the configured Path is not prepended.
+func (s3 *S3) ObjectRaw(key string) Object {
+	s3.clockSkew()
+	return &object{key: key, s3: *s3, raw: true}
+}
+
+func (s3 *S3) client() *http.Client {
+	if s3.Client == nil {
+		return http.DefaultClient
 	}
+	return s3.Client
+}
 
-	// canonicalize amz headers
-	a := make([]string, 0, 1)
-	for k, _ := range req.Header {
-		k = strings.ToLower(k)
-		if strings.HasPrefix(k, "x-amz-") {
-			a = append(a, k)
+// do sends the request with doRetry. Failed reads are sent to the replicas.
+func (s3 *S3) do(req *http.Request) (*http.Response, error) {
+	resp, err := s3.doRetry(req)
+	if len(s3.Replicas) > 0 && failover(req, resp, err) {
+		return s3.doReplicas(req, resp, err)
+	}
+	return resp, err
+}
+
+// doRetry signs and sends the request, retrying transient failures up to
+// MaxRetries times. If the local clock is off, the request is retried once
+// with the S3 time. If the request context is done, the context error is
+// returned.
+func (s3 *S3) doRetry(req *http.Request) (*http.Response, error) {
+	retries := s3.MaxRetries
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	if !replayable || !idempotent(req) {
+		retries = 0
+	}
+
+	skewed := false
+	for attempt := 0; ; attempt++ {
+		r := req
+		if replayable {
+			// signing modifies the header, so each attempt is signed anew
+			r = req.Clone(req.Context())
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				r.Body = body
+			}
+		}
+
+		resp, err := s3.roundTripTimeout(r)
+		if err == nil && replayable && !skewed && s3.adjustSkew(resp) {
+			resp.Body.Close()
+			skewed = true
+			attempt--
+			continue
+		}
+		if attempt >= retries || !retryable(resp, err) {
+			return resp, err
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if err := sleep(req.Context(), backoff(attempt)); err != nil {
+			return nil, err
 		}
 	}
+}
 
-	sort.Strings(a)
+// adjustSkew records the clock skew and reports true if resp is a
+// RequestTimeTooSkewed error. Otherwise resp is left unchanged.
+func (s3 *S3) adjustSkew(resp *http.Response) bool {
+	if resp.StatusCode != 403 {
+		return false
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return false
+	}
+	var e S3Error
+	if xml.Unmarshal(b, &e) != nil || e.Code != "RequestTimeTooSkewed" {
+		return false
+	}
+	t, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		return false
+	}
+	atomic.StoreInt64(s3.clockSkew(), int64(t.Sub(now())))
+	return true
+}
+
+// errTimeout is returned if a request exceeds the configured Timeout
+var errTimeout = fmt.Errorf("s3: request timed out: %w", context.DeadlineExceeded)
+
+// roundTripTimeout is like roundTrip, but applies the configured Timeout
+// until the response header is received
+func (s3 *S3) roundTripTimeout(req *http.Request) (*http.Response, error) {
+	if _, ok := req.Context().Deadline(); ok || s3.Timeout <= 0 {
+		return s3.roundTrip(req)
+	}
+
+	ctx, cancel := context.WithCancel(req.Context())
+	timer := time.AfterFunc(s3.Timeout, cancel)
+	resp, err := s3.roundTrip(req.WithContext(ctx))
+	if !timer.Stop() {
+		if resp != nil {
+			resp.Body.Close()
+		}
+		cancel()
+		if req.Context().Err() != nil {
+			return nil, req.Context().Err()
+		}
+		return nil, errTimeout
+	}
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelReader{rc: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelReader cancels the request context when the body is closed
+type cancelReader struct {
+	rc     io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (r *cancelReader) Read(p []byte) (int, error) {
+	return r.rc.Read(p)
+}
+
+func (r *cancelReader) Close() error {
+	err := r.rc.Close()
+	r.cancel()
+	return err
+}
+
+// roundTrip signs and sends a single request
+func (s3 *S3) roundTrip(req *http.Request) (*http.Response, error) {
+	signer, err := s3.resolve(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", s3.userAgent())
+	signer.signRequest(req)
+
+	start := time.Now()
+	if s3.Logger != nil {
+		s3.Logger.LogRequest(redact(req))
+	}
+	resp, err := s3.client().Do(req)
+	if s3.Logger != nil {
+		s3.Logger.LogResponse(resp, time.Since(start))
+	}
+	if err != nil {
+		if cerr := req.Context().Err(); cerr != nil {
+			return nil, cerr
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Logger logs requests to S3
+type Logger interface {
+	// LogRequest is called before a request is sent. Credentials are removed
+	// from the request.
+	LogRequest(req *http.Request)
 
-	for i, v := range a {
-		k := http.CanonicalHeaderKey(v)
-		vv := req.Header[k]
-		a[i] = v + `:` + strings.Join(vv, `,`) + "\n"
+	// LogResponse is called with the response to the request and the time
+	// it took. The response is nil if the request failed.
+	LogResponse(resp *http.Response, d time.Duration)
+}
+
+// redact returns a copy of req without credentials
+func redact(req *http.Request) *http.Request {
+	r := req.Clone(req.Context())
+	r.Header.Del("Authorization")
+	r.Header.Del("X-Amz-Security-Token")
+	return r
+}
+
+// send sends the request and checks the response status code. A code of 0
+// accepts any status.
+func (s3 *S3) send(req *http.Request, code int, serr string) (*http.Response, error) {
+	resp, err := s3.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if c := resp.StatusCode; code > 0 && c != code {
+		if c == 403 && s3.ExpectedBucketOwner != "" {
+			serr += " (expected bucket owner " + s3.ExpectedBucketOwner + ")"
+		}
+		err := newS3Error(resp, "%s (%s)", serr, http.StatusText(c))
+		resp.Body.Close()
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// newRequest creates a request for the bucket. query must be empty or start
+// with "?".
+func (s3 *S3) newRequest(ctx context.Context, method, query string, body io.Reader) (*http.Request, error) {
+	if err := s3.checkConfig(); err != nil {
+		return nil, err
+	}
+	return http.NewRequestWithContext(ctx, method, s3.bucketURL()+`/`+query, body)
+}
+
+// scheme returns the default URL scheme
+func (s3 *S3) scheme() string {
+	if s3.Insecure {
+		return "http"
+	}
+	return s3proto
+}
+
+// endpoint returns the scheme and host of the configured endpoint or the AWS
+// endpoint of the region
+func (s3 *S3) endpoint() (scheme, host string) {
+	if e := strings.TrimRight(s3.Endpoint, `/`); e != "" {
+		if i := strings.Index(e, `://`); i >= 0 {
+			return e[:i], e[i+3:]
+		}
+		return s3.scheme(), e
+	}
+	if s3.UseAccelerate {
+		if s3.UseDualStack {
+			return s3.scheme(), s3servicehost + `-accelerate.dualstack.` + s3awshost
+		}
+		return s3.scheme(), s3servicehost + `-accelerate.` + s3awshost
+	}
+	if s3.UseDualStack {
+		// there is no global dual-stack endpoint
+		return s3.scheme(), s3servicehost + `.dualstack.` + s3.signingRegion() + `.` + s3awshost
 	}
+	if s3.Region == "" {
+		return s3.scheme(), s3servicehost + `.` + s3awshost
+	}
+	return s3.scheme(), s3servicehost + `.` + s3.Region + `.` + s3awshost
+}
+
+// accelerate reports if the Transfer Acceleration endpoint is used, which
+// only supports virtual-hosted style requests
+func (s3 *S3) accelerate() bool {
+	return s3.UseAccelerate && s3.Endpoint == ""
+}
 
-	canonicalAmzHeaders := strings.Join(a, "")
+// checkConfig returns an error if the access point is invalid or the bucket
+// can't be accelerated
+func (s3 *S3) checkConfig() error {
+	if err := s3.checkAccessPoint(); err != nil {
+		return err
+	}
+	if s3.accelerate() && strings.Contains(s3.Bucket, `.`) {
+		return fmt.Errorf("s3: bucket %q can't use transfer acceleration because its name contains dots", s3.Bucket)
+	}
+	return nil
+}
+
+// bucketURL returns the base URL for requests to the bucket or access
+// point. Virtual-hosted style is used, unless path-style is configured or the
+// bucket name contains dots, which would not match the wildcard TLS
+// certificate.
+func (s3 *S3) bucketURL() string {
+	if ap, ok := s3.accessPoint(); ok {
+		return s3.scheme() + `://` + ap.host(s3.UseDualStack)
+	}
+	scheme, host := s3.endpoint()
+	if !s3.accelerate() && (s3.PathStyle || s3.globalEndpoint() || strings.Contains(s3.Bucket, `.`)) {
+		return scheme + `://` + host + `/` + s3.Bucket
+	}
+	return scheme + `://` + s3.Bucket + `.` + host
+}
+
+// globalEndpoint reports if the global AWS endpoint is used because no region
+// is configured. It keeps using path-style URLs, as it did before regions
+// were supported.
+func (s3 *S3) globalEndpoint() bool {
+	return s3.Region == "" && s3.Endpoint == "" && !s3.UseDualStack && !s3.UseAccelerate
+}
+
+// publicURL returns the base URL of generated links to the bucket
+func (s3 *S3) publicURL() string {
+	d := strings.TrimRight(s3.CustomDomain, `/`)
+	if d == "" {
+		return s3.bucketURL()
+	}
+	if strings.Contains(d, `://`) {
+		return d
+	}
+	return s3.scheme() + `://` + d
+}
+
+// resourcePath returns the request path including the bucket, which is part
+// of the host for virtual-hosted style requests.
+func (s3 *S3) resourcePath(req *http.Request) string {
+	if s3.Bucket != "" && strings.HasPrefix(requestHost(req), s3.Bucket+`.`) {
+		return `/` + s3.Bucket + req.URL.Path
+	}
+	return req.URL.Path
+}
+
+// http://docs.aws.amazon.com/AmazonS3/latest/dev/RESTAuthentication.html
+func (s3 *S3) authString(req *http.Request) string {
+	// the Date header is signed as sent, whatever its format
+	if req.Header.Get("Date") == "" {
+		req.Header.Set("Date", s3.now().UTC().Format(http.TimeFormat))
+	}
 
 	// canonicalize resource
-	cres, rawQuery := canonicalResource(req.URL.Path, req.URL.Query())
-	req.URL.RawQuery = rawQuery
+	cres := canonicalResource(s3.resourcePath(req), req.URL.Query())
 
 	return strings.Join([]string{
 		strings.TrimSpace(req.Method),
 		req.Header.Get("Content-MD5"),
 		req.Header.Get("Content-Type"),
 		req.Header.Get("Date"),
-		canonicalAmzHeaders + cres,
+		canonicalAmzHeaders(req.Header) + cres,
 	}, "\n")
 }
 
-func canonicalResource(path string, query url.Values) (cres, rawQuery string) {
-	p := strings.Split(path, `/`)
-	for i, v := range p {
-		p[i] = escape(v)
+// canonicalAmzHeaders returns the x-amz-* headers for the V2 string to sign.
+// Names are lower case and sorted, values are trimmed and unfolded, and
+// multiple values of a header are joined by commas. Each header is followed
+// by a newline.
+func canonicalAmzHeaders(h http.Header) string {
+	values := make(map[string][]string)
+	a := make([]string, 0, 1)
+	for k, vv := range h {
+		k = strings.ToLower(k)
+		if !strings.HasPrefix(k, "x-amz-") {
+			continue
+		}
+		if _, ok := values[k]; !ok {
+			a = append(a, k)
+		}
+		for _, v := range vv {
+			values[k] = append(values[k], strings.Join(strings.Fields(v), " "))
+		}
+	}
+
+	sort.Strings(a)
+
+	for i, k := range a {
+		a[i] = k + `:` + strings.Join(values[k], `,`) + "\n"
 	}
-	cres = strings.Join(p, `/`)
+	return strings.Join(a, "")
+}
+
+// subresources are the query parameters included in the V2 canonical resource
+var subresources = map[string]bool{
+	"acl":                          true,
+	"cors":                         true,
+	"delete":                       true,
+	"legal-hold":                   true,
+	"lifecycle":                    true,
+	"location":                     true,
+	"logging":                      true,
+	"notification":                 true,
+	"partNumber":                   true,
+	"policy":                       true,
+	"requestPayment":               true,
+	"response-cache-control":       true,
+	"response-content-disposition": true,
+	"response-content-encoding":    true,
+	"response-content-language":    true,
+	"response-content-type":        true,
+	"response-expires":             true,
+	"restore":                      true,
+	"retention":                    true,
+	"select":                       true,
+	"select-type":                  true,
+	"tagging":                      true,
+	"torrent":                      true,
+	"uploadId":                     true,
+	"uploads":                      true,
+	"versionId":                    true,
+	"versioning":                   true,
+	"versions":                     true,
+	"website":                      true,
+}
+
+// canonicalResource returns the V2 canonical resource. Only subresources are
+// part of the canonical resource, other query parameters are not signed.
+// Subresource values are not escaped.
+func canonicalResource(path string, query url.Values) string {
+	cres := escapePath(path)
 
-	if len(query) > 0 {
-		a := make([]string, 0, 1)
-		for k := range query {
+	a := make([]string, 0, len(query))
+	for k := range query {
+		if subresources[k] {
 			a = append(a, k)
 		}
+	}
 
-		sort.Strings(a)
+	sort.Strings(a)
 
-		parts := make([]string, 0, len(a))
-		for _, k := range a {
-			vv := query[k]
-			for _, v := range vv {
-				if v == "" {
-					parts = append(parts, escape(k))
-				} else {
-					parts = append(parts, fmt.Sprintf("%s=%s", escape(k), escape(v)))
-				}
+	subs := make([]string, 0, len(a))
+	for _, k := range a {
+		for _, v := range query[k] {
+			if v == "" {
+				subs = append(subs, escape(k))
+			} else {
+				subs = append(subs, fmt.Sprintf("%s=%s", escape(k), v))
 			}
 		}
-
-		qs := strings.Join(parts, "&")
-
-		rawQuery = qs
-		cres += `?` + qs
 	}
-
-	return
+	if len(subs) > 0 {
+		cres += `?` + strings.Join(subs, "&")
+	}
+	return cres
 }
 
 // escape ensures everything is properly escaped and spaces use %20 instead of +
@@ -112,8 +598,38 @@ func escape(s string) string {
 	return strings.Replace(url.QueryEscape(s), `+`, `%20`, -1)
 }
 
+// escapePath escapes each segment of a slash-separated path
+func escapePath(path string) string {
+	p := strings.Split(path, `/`)
+	for i, v := range p {
+		p[i] = escape(v)
+	}
+	return strings.Join(p, `/`)
+}
+
 func (s3 *S3) signRequest(req *http.Request) {
+	if v := s3.ExpectedBucketOwner; v != "" {
+		req.Header.Set("X-Amz-Expected-Bucket-Owner", v)
+	}
+	if s3.AccessKey == "" && s3.Secret == "" {
+		// anonymous request
+		return
+	}
+	if s3.Token != "" {
+		req.Header.Set("X-Amz-Security-Token", s3.Token)
+	}
+	if s3.RequesterPays {
+		req.Header.Set("X-Amz-Request-Payer", "requester")
+	}
+	if s3.signV4() {
+		s3.signRequestV4(req, s3.now())
+		return
+	}
+
 	authStr := s3.authString(req)
+	if s3.DebugSignature != nil {
+		s3.DebugSignature(req, authStr)
+	}
 
 	h := hmac.New(sha1.New, []byte(s3.Secret))
 	h.Write([]byte(authStr))
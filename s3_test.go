@@ -1,11 +1,51 @@
 package s3
 
 import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
+// newTestS3 returns a configuration whose requests are all served by h
+func newTestS3(t *testing.T, h http.Handler) *S3 {
+	ts := httptest.NewServer(h)
+	t.Cleanup(ts.Close)
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &S3{
+		Bucket:    "bucket",
+		Region:    "us-east-1",
+		AccessKey: "key",
+		Secret:    "secret",
+		Client:    &http.Client{Transport: &testTransport{u}},
+	}
+}
+
+// testTransport redirects all requests to a test server
+type testTransport struct {
+	u *url.URL
+}
+
+func (t *testTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.u.Scheme
+	req.URL.Host = t.u.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
 func TestSignRequest(t *testing.T) {
 	// use unicode values in url
 	req, err := http.NewRequest("GET", "https://bücket/päth/këy?a&c=y&b=ö", nil)
@@ -50,14 +90,643 @@ func TestSignRequest(t *testing.T) {
 	if x := c[5]; x != "x-amz-b:z" {
 		t.Fatal(x)
 	}
-	if x := c[6]; x != `/p%C3%A4th/k%C3%ABy?a&b=%C3%B6&c=y` {
+	// the parameters are not subresources
+	if x := c[6]; x != `/p%C3%A4th/k%C3%ABy` {
 		t.Fatal(x)
 	}
 
 	// sign
 	s3.signRequest(req)
 
-	if x := req.Header.Get(`Authorization`); x != "AWS s3key:USqIVSnPtCdhZqaWq+d3cGvIrhQ=" {
+	if x := req.Header.Get(`Authorization`); x != "AWS s3key:Ja3SRHT7vcdQFKg9nnHfVMZjxJo=" {
+		t.Fatal(x)
+	}
+
+	// non-subresource parameters are sent, but not signed, and the query is
+	// not modified
+	if x := req.URL.RawQuery; x != `a&c=y&b=ö` {
+		t.Fatal(x)
+	}
+}
+
+func TestSignRequestVersionId(t *testing.T) {
+	var uri string
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uri = r.URL.RequestURI()
+		w.WriteHeader(204)
+	}))
+
+	u := "https://bucket.s3.amazonaws.com/key?x-id=DeleteObject&versionId=3%2FL4kqtJlcpXroDTDmJ%2Brmsh"
+	req, err := http.NewRequest("DELETE", u, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Date", "date")
+	// subresource values are signed unescaped
+	expected := "DELETE\n\n\ndate\n/bucket/key?versionId=3/L4kqtJlcpXroDTDmJ+rmsh"
+	if x := s3.authString(req); x != expected {
+		t.Fatal(x)
+	}
+	if x := req.URL.String(); x != u {
+		t.Fatal(x)
+	}
+
+	if _, err := s3.send(req, 204, ""); err != nil {
+		t.Fatal(err)
+	}
+	if x := uri; x != "/key?x-id=DeleteObject&versionId=3%2FL4kqtJlcpXroDTDmJ%2Brmsh" {
+		t.Fatal(x)
+	}
+}
+
+func TestRegion(t *testing.T) {
+	for _, v := range []struct {
+		region string
+		url    string
+		scope  string
+	}{
+		{"", "https://s3.amazonaws.com/bucket/key.txt", "us-east-1"},
+		{"us-west-2", "https://bucket.s3.us-west-2.amazonaws.com/key.txt", "us-west-2"},
+		{"eu-west-1", "https://bucket.s3.eu-west-1.amazonaws.com/key.txt", "eu-west-1"},
+	} {
+		s3 := &S3{Bucket: "bucket", Region: v.region}
+		o := s3.Object("key.txt").(*object)
+
+		if x := o.url(""); x != v.url {
+			t.Fatal(x)
+		}
+		if x := o.resource(""); x != "/bucket/key.txt" {
+			t.Fatal(x)
+		}
+		if x := s3.scopeV4(time.Time{}); x != "00010101/"+v.scope+"/s3/aws4_request" {
+			t.Fatal(x)
+		}
+	}
+}
+
+func TestVirtualHostedResource(t *testing.T) {
+	s3 := &S3{Bucket: "bucket", Region: "eu-west-1"}
+	for _, u := range []string{
+		"https://bucket.s3.eu-west-1.amazonaws.com/key",
+		"https://s3.eu-west-1.amazonaws.com/bucket/key",
+	} {
+		req, err := http.NewRequest("GET", u, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Date", "date")
+		if x := s3.authString(req); x != "GET\n\n\ndate\n/bucket/key" {
+			t.Fatal(x)
+		}
+	}
+}
+
+func TestDottedBucket(t *testing.T) {
+	s3 := &S3{Bucket: "my.bucket", Region: "eu-west-1"}
+	if x := s3.Object("key.txt").(*object).url(""); x != "https://s3.eu-west-1.amazonaws.com/my.bucket/key.txt" {
 		t.Fatal(x)
 	}
 }
+
+func TestToken(t *testing.T) {
+	for _, token := range []string{"", "session-token"} {
+		s3 := &S3{
+			Bucket:    "bucket",
+			AccessKey: "key",
+			Secret:    "secret",
+			Token:     token,
+		}
+
+		// header, signed for v2 and v4
+		for _, version := range []int{2, 4} {
+			s3.SignatureVersion = version
+			req, err := http.NewRequest("GET", "https://bucket.s3.amazonaws.com/key", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s3.signRequest(req)
+			if x := req.Header.Get("X-Amz-Security-Token"); x != token {
+				t.Fatal(x)
+			}
+			if version == 2 {
+				if x := s3.authString(req); strings.Contains(x, "x-amz-security-token:session-token\n") != (token != "") {
+					t.Fatal(x)
+				}
+			} else {
+				if x := req.Header.Get("Authorization"); strings.Contains(x, "x-amz-security-token") != (token != "") {
+					t.Fatal(x)
+				}
+			}
+		}
+
+		// query params
+		s3.SignatureVersion = 0
+		o := s3.Object("key")
+		u, err := o.ExpiringURL(time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if x := u.Query().Get("x-amz-security-token"); x != token {
+			t.Fatal(x)
+		}
+		u, err = o.FormURL(Private, make(Policy))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if x := u.Query().Get("x-amz-security-token"); x != token {
+			t.Fatal(x)
+		}
+	}
+}
+
+func TestSubresources(t *testing.T) {
+	s3 := &S3{Bucket: "bucket"}
+	req, err := http.NewRequest("GET", "https://bucket.s3.amazonaws.com/key?list-type=2&prefix=a&uploadId=ö&acl", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Date", "date")
+
+	// other parameters are sent, but not signed
+	if x := s3.authString(req); x != "GET\n\n\ndate\n/bucket/key?acl&uploadId=ö" {
+		t.Fatal(x)
+	}
+	if x := req.URL.RawQuery; x != "list-type=2&prefix=a&uploadId=ö&acl" {
+		t.Fatal(x)
+	}
+}
+
+func TestEndpoint(t *testing.T) {
+	for _, v := range []struct {
+		endpoint  string
+		pathStyle bool
+		insecure  bool
+		url       string
+	}{
+		{"http://localhost:9000", true, false, "http://localhost:9000/bucket/dir/key.txt"},
+		{"http://localhost:9000/", false, false, "http://bucket.localhost:9000/dir/key.txt"},
+		{"minio.example.com", true, false, "https://minio.example.com/bucket/dir/key.txt"},
+		{"", true, false, "https://s3.amazonaws.com/bucket/dir/key.txt"},
+
+		// an explicit scheme takes precedence over Insecure
+		{"localhost:9000", true, true, "http://localhost:9000/bucket/dir/key.txt"},
+		{"https://minio.example.com", true, true, "https://minio.example.com/bucket/dir/key.txt"},
+		{"", false, true, "http://s3.amazonaws.com/bucket/dir/key.txt"},
+	} {
+		s3 := &S3{
+			Bucket:    "bucket",
+			Endpoint:  v.endpoint,
+			PathStyle: v.pathStyle,
+			Insecure:  v.insecure,
+		}
+		o := s3.Object("dir/key.txt").(*object)
+		if x := o.url(""); x != v.url {
+			t.Fatal(x)
+		}
+
+		// the signed resource is the same for both styles
+		req, err := http.NewRequest("GET", o.url(""), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Date", "date")
+		if x := s3.authString(req); x != "GET\n\n\ndate\n/bucket/dir/key.txt" {
+			t.Fatal(x)
+		}
+	}
+}
+
+func TestCustomDomain(t *testing.T) {
+	s3 := &S3{
+		Bucket:       "assets.example.com",
+		AccessKey:    "key",
+		Secret:       "secret",
+		CustomDomain: "assets.example.com",
+	}
+	o := s3.Object("dir/key.txt")
+
+	u, err := o.ExpiringURL(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := u.Scheme + "://" + u.Host + u.Path; x != "https://assets.example.com/dir/key.txt" {
+		t.Fatal(x)
+	}
+
+	// the signature covers the bucket resource
+	mac := hmac.New(sha1.New, []byte("secret"))
+	mac.Write([]byte("GET\n\n\n" + u.Query().Get("Expires") + "\n/assets.example.com/dir/key.txt"))
+	if x := u.Query().Get("Signature"); x != base64.StdEncoding.EncodeToString(mac.Sum(nil)) {
+		t.Fatal(x)
+	}
+
+	s3.SignatureVersion = 4
+	u, err = s3.Object("dir/key.txt").ExpiringURL(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := u.Host + u.Path; x != "assets.example.com/dir/key.txt" {
+		t.Fatal(x)
+	}
+
+	s3.CustomDomain = "http://assets.example.com/"
+	u, err = s3.Object("dir/key.txt").FormURL(Private, make(Policy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := u.Scheme + "://" + u.Host + u.Path; x != "http://assets.example.com" {
+		t.Fatal(x)
+	}
+
+	// requests are sent to the endpoint
+	if x := s3.Object("key").(*object).url(""); x != "https://s3.amazonaws.com/assets.example.com/key" {
+		t.Fatal(x)
+	}
+}
+
+func TestDualStack(t *testing.T) {
+	for _, v := range []struct {
+		region    string
+		dualStack bool
+		pathStyle bool
+		url       string
+	}{
+		{"eu-west-1", true, false, "https://bucket.s3.dualstack.eu-west-1.amazonaws.com/key"},
+		{"eu-west-1", false, false, "https://bucket.s3.eu-west-1.amazonaws.com/key"},
+		{"ap-southeast-2", true, true, "https://s3.dualstack.ap-southeast-2.amazonaws.com/bucket/key"},
+		{"", true, false, "https://bucket.s3.dualstack.us-east-1.amazonaws.com/key"},
+		{"", false, false, "https://s3.amazonaws.com/bucket/key"},
+	} {
+		s3 := &S3{
+			Bucket:       "bucket",
+			Region:       v.region,
+			UseDualStack: v.dualStack,
+			PathStyle:    v.pathStyle,
+		}
+		if x := s3.Object("key").(*object).url(""); x != v.url {
+			t.Fatal(x)
+		}
+	}
+
+	// a custom endpoint takes precedence
+	s3 := &S3{Bucket: "bucket", Endpoint: "http://localhost:9000", UseDualStack: true, PathStyle: true}
+	if x := s3.Object("key").(*object).url(""); x != "http://localhost:9000/bucket/key" {
+		t.Fatal(x)
+	}
+}
+
+func TestAccelerate(t *testing.T) {
+	for _, v := range []struct {
+		dualStack bool
+		pathStyle bool
+		url       string
+	}{
+		{false, false, "https://bucket.s3-accelerate.amazonaws.com/key"},
+		{true, false, "https://bucket.s3-accelerate.dualstack.amazonaws.com/key"},
+		{false, true, "https://bucket.s3-accelerate.amazonaws.com/key"},
+	} {
+		s3 := &S3{
+			Bucket:        "bucket",
+			Region:        "eu-west-1",
+			UseAccelerate: true,
+			UseDualStack:  v.dualStack,
+			PathStyle:     v.pathStyle,
+		}
+		if x := s3.Object("key").(*object).url(""); x != v.url {
+			t.Fatal(x)
+		}
+	}
+
+	// bucket names with dots are rejected
+	s3 := &S3{Bucket: "my.bucket", UseAccelerate: true}
+	if _, err := s3.Object("key").Head(); err == nil || !strings.Contains(err.Error(), "dots") {
+		t.Fatal(err)
+	}
+	if _, err := s3.Object("key").ExpiringURL(time.Minute); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := s3.List(""); err == nil {
+		t.Fatal("expected error")
+	}
+
+	// a custom endpoint takes precedence
+	s3 = &S3{Bucket: "my.bucket", Endpoint: "http://localhost:9000", UseAccelerate: true}
+	if x := s3.Object("key").(*object).url(""); x != "http://localhost:9000/my.bucket/key" {
+		t.Fatal(x)
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	s3, f := newFakeS3(t)
+	if err := s3.Object("key").Put(strings.NewReader("x"), 1); err != nil {
+		t.Fatal(err)
+	}
+	if x := f.lastHeader().Get("User-Agent"); x != "go-s3" {
+		t.Fatal(x)
+	}
+
+	s3.UserAgent = "myapp/1.2.3"
+	w := s3.Object("key").WriterWithOptions(UploadOptions{SinglePutThreshold: -1})
+	w.Write([]byte("x"))
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if x := f.lastHeader().Get("User-Agent"); x != "go-s3 myapp/1.2.3" {
+		t.Fatal(x)
+	}
+}
+
+func TestAnonymous(t *testing.T) {
+	var auth []string
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+		w.Write([]byte("public"))
+	}))
+	s3.AccessKey = ""
+	s3.Secret = ""
+
+	for _, version := range []int{2, 4} {
+		s3.SignatureVersion = version
+		r, _, err := s3.Object("key").Reader()
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Close()
+		if _, err := s3.Object("key").Head(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(auth) != 4 || strings.Join(auth, "") != "" {
+		t.Fatal(auth)
+	}
+}
+
+func TestRequesterPays(t *testing.T) {
+	s3, f := newFakeS3(t)
+	s3.RequesterPays = true
+	o := s3.Object("key")
+
+	if err := o.Put(strings.NewReader("data"), 4); err != nil {
+		t.Fatal(err)
+	}
+	if x := f.lastHeader().Get("X-Amz-Request-Payer"); x != "requester" {
+		t.Fatal(x)
+	}
+	if _, err := o.Head(); err != nil {
+		t.Fatal(err)
+	}
+	if x := f.lastHeader().Get("X-Amz-Request-Payer"); x != "requester" {
+		t.Fatal(x)
+	}
+	r, _, err := o.Reader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Close()
+	if x := f.lastHeader().Get("X-Amz-Request-Payer"); x != "requester" {
+		t.Fatal(x)
+	}
+	if _, err := s3.ListMultipartUploads(""); err != nil {
+		t.Fatal(err)
+	}
+	if x := f.lastHeader().Get("X-Amz-Request-Payer"); x != "requester" {
+		t.Fatal(x)
+	}
+
+	// signed with v2
+	req, err := http.NewRequest("GET", "https://bucket.s3.amazonaws.com/key", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Date", "date")
+	s3.signRequest(req)
+	if x := s3.authString(req); x != "GET\n\n\ndate\nx-amz-request-payer:requester\n/bucket/key" {
+		t.Fatal(x)
+	}
+}
+
+func TestCanonicalAmzHeaders(t *testing.T) {
+	h := http.Header{
+		"X-Amz-Meta-Username": {"fred", "barney"},
+		"x-amz-acl":           {"public-read"},
+		"X-Amz-Meta-Note":     {"  long\n   folded value "},
+		"X-Amz-Date":          {"Tue, 27 Mar 2007 19:36:42 +0000"},
+		"Content-Type":        {"text/plain"},
+		"Content-Md5":         {"abc"},
+	}
+	expected := "x-amz-acl:public-read\n" +
+		"x-amz-date:Tue, 27 Mar 2007 19:36:42 +0000\n" +
+		"x-amz-meta-note:long folded value\n" +
+		"x-amz-meta-username:fred,barney\n"
+	if x := canonicalAmzHeaders(h); x != expected {
+		t.Fatal(x)
+	}
+	if x := canonicalAmzHeaders(http.Header{"Date": {"date"}}); x != "" {
+		t.Fatal(x)
+	}
+
+	// the headers are signed
+	req, err := http.NewRequest("PUT", "https://bucket.s3.amazonaws.com/key", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Date", "date")
+	req.Header.Set("Content-Type", "text/plain")
+	req.Header.Set("X-Amz-Storage-Class", "STANDARD_IA")
+	req.Header.Set("X-Amz-Acl", "private")
+	s3 := &S3{Bucket: "bucket"}
+	expected = "PUT\n\ntext/plain\ndate\nx-amz-acl:private\nx-amz-storage-class:STANDARD_IA\n/bucket/key"
+	if x := s3.authString(req); x != expected {
+		t.Fatal(x)
+	}
+}
+
+type testLogger struct {
+	requests  []*http.Request
+	responses []*http.Response
+}
+
+func (l *testLogger) LogRequest(req *http.Request) {
+	l.requests = append(l.requests, req)
+}
+
+func (l *testLogger) LogResponse(resp *http.Response, d time.Duration) {
+	l.responses = append(l.responses, resp)
+}
+
+func TestLogger(t *testing.T) {
+	s3, f := newFakeS3(t)
+	f.put("/key", []byte("data"), nil)
+	s3.Token = "token"
+	l := new(testLogger)
+	s3.Logger = l
+
+	if _, err := s3.Object("key").Head(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s3.Object("missing").Head(); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if len(l.requests) != 2 || len(l.responses) != 2 {
+		t.Fatal(len(l.requests), len(l.responses))
+	}
+	req := l.requests[0]
+	if x := req.Method + " " + req.URL.String(); x != "HEAD https://bucket.s3.us-east-1.amazonaws.com/key" {
+		t.Fatal(x)
+	}
+	if req.Header.Get("Authorization") != "" || req.Header.Get("X-Amz-Security-Token") != "" || req.Header.Get("Date") == "" {
+		t.Fatal(req.Header)
+	}
+	if l.responses[0].StatusCode != 200 || l.responses[1].StatusCode != 404 {
+		t.Fatal(l.responses[0].StatusCode, l.responses[1].StatusCode)
+	}
+
+	// the request is still signed
+	if f.lastHeader().Get("Authorization") == "" {
+		t.Fatal("unsigned request")
+	}
+
+	// failed requests
+	s3.Client = &http.Client{Transport: errTransport{}}
+	s3.Object("key").Head()
+	if len(l.responses) != 3 || l.responses[2] != nil {
+		t.Fatal(l.responses)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+			return
+		}
+		// the body is sent after the timeout
+		w.Write([]byte("a"))
+		w.(http.Flusher).Flush()
+		time.Sleep(100 * time.Millisecond)
+		w.Write([]byte("b"))
+	}))
+	s3.Timeout = 50 * time.Millisecond
+
+	start := time.Now()
+	_, err := s3.Object("slow").Head()
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Fatal(d)
+	}
+
+	r, _, err := s3.Object("stream").Reader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil || string(b) != "ab" {
+		t.Fatal(string(b), err)
+	}
+
+	// a context deadline takes precedence
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	r, _, err = s3.Object("stream").ReaderContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Close()
+}
+
+func TestTimeoutRetry(t *testing.T) {
+	var n int32
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the first attempt stalls until it times out
+		if atomic.AddInt32(&n, 1) == 1 {
+			<-r.Context().Done()
+		}
+	}))
+	s3.Timeout = 50 * time.Millisecond
+	s3.MaxRetries = 2
+
+	if _, err := s3.Object("key").Head(); err != nil {
+		t.Fatal(err)
+	}
+	if x := atomic.LoadInt32(&n); x != 2 {
+		t.Fatal(x)
+	}
+}
+
+func TestSignedDate(t *testing.T) {
+	defer func(f func() time.Time) { now = f }(now)
+	now = func() time.Time {
+		return time.Date(2015, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600))
+	}
+
+	var dates, sigs []string
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mac := hmac.New(sha1.New, []byte("secret"))
+		mac.Write([]byte("HEAD\n\n\n" + r.Header.Get("Date") + "\n/bucket/key"))
+		dates = append(dates, r.Header.Get("Date"))
+		sigs = append(sigs, base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+		if x := r.Header.Get("Authorization"); x != "AWS key:"+sigs[len(sigs)-1] {
+			t.Error(x)
+		}
+	}))
+
+	if _, err := s3.Object("key").Head(); err != nil {
+		t.Fatal(err)
+	}
+	if x := dates[0]; x != "Fri, 02 Jan 2015 02:04:05 GMT" {
+		t.Fatal(x)
+	}
+	if d, err := (Header{"Date": dates}).Date(); err != nil || !d.Equal(now()) {
+		t.Fatal(d, err)
+	}
+}
+
+func TestExpectedBucketOwner(t *testing.T) {
+	f := newFakeServer()
+	var signed []string
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if x := r.Header.Get("X-Amz-Expected-Bucket-Owner"); x != "111122223333" {
+			t.Errorf("%s: %q", r.Method, x)
+		}
+		if r.URL.Path == "/other" {
+			f.error(w, 403, "AccessDenied")
+			return
+		}
+		f.ServeHTTP(w, r)
+	}))
+	s3.ExpectedBucketOwner = "111122223333"
+	s3.DebugSignature = func(req *http.Request, stringToSign string) {
+		signed = append(signed, stringToSign)
+	}
+
+	if err := s3.Object("key").Put(strings.NewReader("data"), 4); err != nil {
+		t.Fatal(err)
+	}
+	r, _, err := s3.Object("key").Reader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Close()
+	for _, s := range signed {
+		if !strings.Contains(s, "\nx-amz-expected-bucket-owner:111122223333\n") {
+			t.Fatal(s)
+		}
+	}
+	if len(signed) != 2 {
+		t.Fatal(signed)
+	}
+
+	_, _, err = s3.Object("other").Reader()
+	if e, ok := err.(*S3Error); !ok || e.Code != "AccessDenied" || !strings.Contains(e.Error(), "expected bucket owner 111122223333") {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,145 @@
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"hash"
+	"io"
+	"sync"
+)
+
+func (o *object) PutSeeker(r io.ReadSeeker, opts ...PutOption) (err error) {
+	var uo UploadOptions
+	for _, opt := range opts {
+		opt(&uo)
+	}
+
+	start, err := r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	end, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			r.Seek(start, io.SeekStart)
+		}
+	}()
+	size := end - start
+
+	if uo.Gzip || size > uo.singlePutThreshold() {
+		if _, err := r.Seek(start, io.SeekStart); err != nil {
+			return err
+		}
+		if !uo.Gzip {
+			uo.Size = size
+		}
+		w := o.WriterWithOptions(uo)
+		if _, err := io.Copy(w, io.LimitReader(r, size)); err != nil {
+			w.Abort()
+			return err
+		}
+		return w.Close()
+	}
+	return o.putSeeker(context.Background(), r, start, size, uo)
+}
+
+// putSeeker uploads size bytes of r at offset start with a single request.
+// The data is read twice, first to compute the checksums.
+func (o *object) putSeeker(ctx context.Context, r io.ReadSeeker, start, size int64, uo UploadOptions) error {
+	h := md5.New()
+	w := io.Writer(h)
+	var ch hash.Hash
+	if uo.Checksum != "" {
+		ch = uo.Checksum.newHash()
+		w = io.MultiWriter(h, ch)
+	}
+	// the first bytes are kept to detect the content type
+	head := make([]byte, 512)
+	if size < 512 {
+		head = head[:size]
+	}
+	// the bodies of retries may still be read by the transport when the
+	// next one starts, so they share a lock
+	var mu sync.Mutex
+	newBody := func() *seekerBody {
+		return &seekerBody{r: r, mu: &mu, off: start, end: start + size}
+	}
+	body := newBody()
+	if _, err := io.ReadFull(body, head); err != nil {
+		return err
+	}
+	w.Write(head)
+	if _, err := io.Copy(w, body); err != nil {
+		return err
+	}
+
+	var rb io.Reader
+	if size > 0 {
+		rb = newBody()
+	}
+	req, err := o.newRequest(ctx, "PUT", "", rb)
+	if err != nil {
+		return err
+	}
+	if size > 0 {
+		req.ContentLength = size
+		req.GetBody = func() (io.ReadCloser, error) {
+			return newBody(), nil
+		}
+	}
+	uo.setHeaders(req.Header, o.key, head)
+	newRateLimiter(uo.RateLimit).limitBody(req)
+	uo.Preconditions.setHeaders(req.Header)
+
+	req.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(h.Sum(nil)))
+	if ch != nil {
+		req.Header.Set(uo.Checksum.header(), base64.StdEncoding.EncodeToString(ch.Sum(nil)))
+	}
+
+	resp, err := o.s3.send(req, 200, "error putting object")
+	if err != nil {
+		return preconditionError(err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// seekerBody reads the bytes off through end of r. It seeks before each
+// read while holding mu, which is shared by all bodies of r, so that request
+// bodies created for retries don't interfere.
+type seekerBody struct {
+	r        io.ReadSeeker
+	mu       *sync.Mutex
+	off, end int64
+}
+
+func (b *seekerBody) Read(p []byte) (int, error) {
+	if b.off >= b.end {
+		return 0, io.EOF
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, err := b.r.Seek(b.off, io.SeekStart); err != nil {
+		return 0, err
+	}
+	if rem := b.end - b.off; int64(len(p)) > rem {
+		p = p[:rem]
+	}
+	n, err := b.r.Read(p)
+	b.off += int64(n)
+	if err == io.EOF {
+		err = nil
+		if n == 0 {
+			err = io.ErrUnexpectedEOF
+		}
+	}
+	return n, err
+}
+
+func (b *seekerBody) Close() error {
+	return nil
+}
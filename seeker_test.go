@@ -0,0 +1,140 @@
+package s3
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"testing/iotest"
+)
+
+// tempFile returns a file with data
+func tempFile(t *testing.T, data []byte) *os.File {
+	path := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	return f
+}
+
+func TestPutSeeker(t *testing.T) {
+	f := newFakeServer()
+	fail := 1
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" && fail > 0 {
+			fail--
+			f.error(w, 500, "InternalError")
+			return
+		}
+		f.ServeHTTP(w, r)
+	}))
+	s3.MaxRetries = 1
+
+	data := []byte("<html><body>seekable data</body></html>")
+	file := tempFile(t, data)
+	file.Seek(6, io.SeekStart)
+
+	// the first attempt fails and is retried
+	if err := s3.Object("key").PutSeeker(file, WithChecksum(ChecksumSHA256)); err != nil {
+		t.Fatal(err)
+	}
+	o := f.get("/key")
+	if o == nil || !bytes.Equal(o.body, data[6:]) {
+		t.Fatal(o)
+	}
+	h := f.lastHeader()
+	if x := h.Get("Content-MD5"); x != contentMD5(data[6:]) {
+		t.Fatal(x)
+	}
+	if x := h.Get("X-Amz-Checksum-Sha256"); x != ChecksumSHA256.checksum(data[6:]) {
+		t.Fatal(x)
+	}
+	if x := h.Get("Content-Length"); x != "33" {
+		t.Fatal(x)
+	}
+	if x := h.Get("Content-Type"); x != "text/html; charset=utf-8" {
+		t.Fatal(x)
+	}
+	if fail != 0 {
+		t.Fatal(fail)
+	}
+
+	// the position is restored on failure
+	fail = 2
+	file.Seek(10, io.SeekStart)
+	if err := s3.Object("key").PutSeeker(file); err == nil {
+		t.Fatal("expected error")
+	}
+	if pos, _ := file.Seek(0, io.SeekCurrent); pos != 10 {
+		t.Fatal(pos)
+	}
+
+	// empty
+	if err := s3.Object("empty").PutSeeker(bytes.NewReader(nil)); err != nil {
+		t.Fatal(err)
+	}
+	if o := f.get("/empty"); o == nil || len(o.body) != 0 {
+		t.Fatal(o)
+	}
+}
+
+func TestPutSeekerMultipart(t *testing.T) {
+	s3, f := newFakeS3(t)
+	data := bytes.Repeat([]byte("0123456789"), MinPartSize/10+1)
+	file := tempFile(t, data)
+
+	if err := s3.Object("key").PutSeeker(file); err != nil {
+		t.Fatal(err)
+	}
+	if o := f.get("/key"); o == nil || !bytes.Equal(o.body, data) || len(o.parts) != 2 {
+		t.Fatal(o)
+	}
+}
+
+// yieldingReader lets other goroutines run between seeking and reading
+type yieldingReader struct {
+	*bytes.Reader
+}
+
+func (r yieldingReader) Seek(offset int64, whence int) (int64, error) {
+	defer runtime.Gosched()
+	return r.Reader.Seek(offset, whence)
+}
+
+func TestSeekerBodyConcurrent(t *testing.T) {
+	data := make([]byte, 1<<16)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	r := yieldingReader{bytes.NewReader(data)}
+	var mu sync.Mutex
+
+	// a retry body is read while the transport still reads the previous one
+	errs := make(chan error, 2)
+	for _, off := range []int64{0, 1} {
+		go func(off int64) {
+			body := &seekerBody{r: r, mu: &mu, off: off, end: int64(len(data))}
+			got, err := ioutil.ReadAll(iotest.OneByteReader(body))
+			if err == nil && !bytes.Equal(got, data[off:]) {
+				err = fmt.Errorf("offset %d: wrong data", off)
+			}
+			errs <- err
+		}(off)
+	}
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+}
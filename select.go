@@ -0,0 +1,144 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+)
+
+// InputFormat describes the format of an object queried with Select. Either
+// CSV or JSON must be set.
+type InputFormat struct {
+	CSV  *CSVInput  `xml:",omitempty"`
+	JSON *JSONInput `xml:",omitempty"`
+
+	// CompressionType is "NONE", "GZIP" or "BZIP2". Defaults to "NONE".
+	CompressionType string `xml:",omitempty"`
+}
+
+// CSVInput describes CSV input of Select. Empty fields use the S3 defaults.
+type CSVInput struct {
+	// FileHeaderInfo is "USE", "IGNORE" or "NONE"
+	FileHeaderInfo  string `xml:",omitempty"`
+	Comments        string `xml:",omitempty"`
+	QuoteCharacter  string `xml:",omitempty"`
+	FieldDelimiter  string `xml:",omitempty"`
+	RecordDelimiter string `xml:",omitempty"`
+}
+
+// JSONInput describes JSON input of Select
+type JSONInput struct {
+	// Type is "DOCUMENT" or "LINES"
+	Type string
+}
+
+// OutputFormat describes the format of the records returned by Select.
+// Either CSV or JSON must be set.
+type OutputFormat struct {
+	CSV  *CSVOutput  `xml:",omitempty"`
+	JSON *JSONOutput `xml:",omitempty"`
+}
+
+// CSVOutput describes CSV output of Select. Empty fields use the S3
+// defaults.
+type CSVOutput struct {
+	// QuoteFields is "ALWAYS" or "ASNEEDED"
+	QuoteFields     string `xml:",omitempty"`
+	QuoteCharacter  string `xml:",omitempty"`
+	FieldDelimiter  string `xml:",omitempty"`
+	RecordDelimiter string `xml:",omitempty"`
+}
+
+// JSONOutput describes JSON output of Select
+type JSONOutput struct {
+	RecordDelimiter string `xml:",omitempty"`
+}
+
+type selectRequest struct {
+	XMLName             xml.Name `xml:"SelectObjectContentRequest"`
+	Expression          string
+	ExpressionType      string
+	InputSerialization  InputFormat
+	OutputSerialization OutputFormat
+}
+
+// Select runs the SQL query on the object and returns the matching records
+// in the output format. Errors that occur while the query runs are returned
+// by Read as *S3Error.
+//
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_SelectObjectContent.html
+func (o *object) Select(query string, input InputFormat, output OutputFormat) (io.ReadCloser, error) {
+	b, err := xml.Marshal(selectRequest{
+		Expression:          query,
+		ExpressionType:      "SQL",
+		InputSerialization:  input,
+		OutputSerialization: output,
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := o.newRequest(context.Background(), "POST", "?select&select-type=2", bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := o.s3.send(req, 200, "error selecting object content")
+	if err != nil {
+		return nil, err
+	}
+	return &selectReader{rc: resp.Body}, nil
+}
+
+// selectReader returns the payloads of the Records events of a Select event
+// stream
+type selectReader struct {
+	rc  io.ReadCloser
+	buf []byte
+	err error
+}
+
+func (r *selectReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 && r.err == nil {
+		r.err = r.next()
+	}
+	if len(r.buf) == 0 {
+		return 0, r.err
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// next reads the next message. Records are buffered, and the End event ends
+// the stream.
+func (r *selectReader) next() error {
+	m, err := readEventMessage(r.rc)
+	if err == io.EOF {
+		// the stream must be terminated by an End event
+		return io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return err
+	}
+
+	switch m.headers[":message-type"] {
+	case "error":
+		return &S3Error{
+			Code:    m.headers[":error-code"],
+			Message: m.headers[":error-message"],
+			text:    "error selecting object content",
+		}
+	case "event":
+		switch m.headers[":event-type"] {
+		case "Records":
+			r.buf = m.payload
+		case "End":
+			return io.EOF
+		}
+	}
+	return nil
+}
+
+func (r *selectReader) Close() error {
+	return r.rc.Close()
+}
@@ -0,0 +1,126 @@
+package s3
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// eventMessageBytes encodes an event stream message with string headers
+func eventMessageBytes(headers [][2]string, payload string) []byte {
+	var h bytes.Buffer
+	for _, kv := range headers {
+		h.WriteByte(byte(len(kv[0])))
+		h.WriteString(kv[0])
+		h.WriteByte(7)
+		binary.Write(&h, binary.BigEndian, uint16(len(kv[1])))
+		h.WriteString(kv[1])
+	}
+
+	var b bytes.Buffer
+	binary.Write(&b, binary.BigEndian, uint32(16+h.Len()+len(payload)))
+	binary.Write(&b, binary.BigEndian, uint32(h.Len()))
+	binary.Write(&b, binary.BigEndian, crc32.ChecksumIEEE(b.Bytes()))
+	b.Write(h.Bytes())
+	b.WriteString(payload)
+	binary.Write(&b, binary.BigEndian, crc32.ChecksumIEEE(b.Bytes()))
+	return b.Bytes()
+}
+
+func eventBytes(eventType, payload string) []byte {
+	return eventMessageBytes([][2]string{
+		{":message-type", "event"},
+		{":event-type", eventType},
+		{":content-type", "application/octet-stream"},
+	}, payload)
+}
+
+func TestSelect(t *testing.T) {
+	var stream []byte
+	var body, query string
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body, query = string(b), r.URL.RawQuery
+		w.Write(stream)
+	}))
+
+	stream = bytes.Join([][]byte{
+		eventBytes("Records", "a,1\n"),
+		eventBytes("Progress", "<Progress/>"),
+		eventBytes("Records", "b,2\n"),
+		eventBytes("Stats", "<Stats/>"),
+		eventBytes("End", ""),
+	}, nil)
+	r, err := s3.Object("data.csv").Select(
+		"SELECT * FROM S3Object s",
+		InputFormat{CSV: &CSVInput{FileHeaderInfo: "USE"}},
+		OutputFormat{CSV: &CSVOutput{}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil || string(b) != "a,1\nb,2\n" {
+		t.Fatal(string(b), err)
+	}
+	if query != "select&select-type=2" {
+		t.Fatal(query)
+	}
+	expected := `<SelectObjectContentRequest><Expression>SELECT * FROM S3Object s</Expression><ExpressionType>SQL</ExpressionType>` +
+		`<InputSerialization><CSV><FileHeaderInfo>USE</FileHeaderInfo></CSV></InputSerialization>` +
+		`<OutputSerialization><CSV></CSV></OutputSerialization></SelectObjectContentRequest>`
+	if body != expected {
+		t.Fatal(body)
+	}
+
+	// error event
+	stream = append(eventBytes("Records", "a"), eventMessageBytes([][2]string{
+		{":message-type", "error"},
+		{":error-code", "CSVParsingError"},
+		{":error-message", "bad csv"},
+	}, "")...)
+	r, _ = s3.Object("data.csv").Select("SELECT 1", InputFormat{JSON: &JSONInput{Type: "LINES"}}, OutputFormat{JSON: &JSONOutput{}})
+	b, err = ioutil.ReadAll(r)
+	if e, ok := err.(*S3Error); !ok || e.Code != "CSVParsingError" || string(b) != "a" {
+		t.Fatal(string(b), err)
+	}
+
+	// missing End event
+	stream = eventBytes("Records", "a")
+	r, _ = s3.Object("data.csv").Select("SELECT 1", InputFormat{}, OutputFormat{})
+	if _, err := ioutil.ReadAll(r); err != io.ErrUnexpectedEOF {
+		t.Fatal(err)
+	}
+
+	// corrupt message
+	stream = eventBytes("Records", "a")
+	stream[len(stream)-5] ^= 1
+	r, _ = s3.Object("data.csv").Select("SELECT 1", InputFormat{}, OutputFormat{})
+	if _, err := ioutil.ReadAll(r); err != errEventStreamCRC {
+		t.Fatal(err)
+	}
+}
+
+func TestReadEventMessage(t *testing.T) {
+	b := eventBytes("Records", "payload")
+	m, err := readEventMessage(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(m.payload) != "payload" || m.headers[":event-type"] != "Records" || len(m.headers) != 3 {
+		t.Fatal(m)
+	}
+
+	if _, err := readEventMessage(bytes.NewReader(b[:len(b)-1])); err != io.ErrUnexpectedEOF {
+		t.Fatal(err)
+	}
+	if _, err := readEventMessage(strings.NewReader("")); err != io.EOF {
+		t.Fatal(err)
+	}
+}
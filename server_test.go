@@ -0,0 +1,520 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeServer is a minimal in-memory S3 implementation for tests. Objects are
+// stored by request path.
+type fakeServer struct {
+	mu       sync.Mutex
+	objects  map[string]*fakeObject
+	uploads  map[string]*fakeUpload
+	requests []string
+	header   http.Header
+	nextId   int
+
+	// pageSize limits the number of listed uploads and parts per page
+	pageSize int
+}
+
+type fakeObject struct {
+	header http.Header
+	body   []byte
+	parts  []int
+}
+
+type fakeUpload struct {
+	path      string
+	header    http.Header
+	parts     map[int][]byte
+	initiated time.Time
+}
+
+// newFakeS3 returns a configuration whose requests are served by a new fake
+// server.
+func newFakeS3(t *testing.T) (*S3, *fakeServer) {
+	f := newFakeServer()
+	return newTestS3(t, f), f
+}
+
+// newFakeServer returns an empty fake server
+func newFakeServer() *fakeServer {
+	return &fakeServer{
+		objects: make(map[string]*fakeObject),
+		uploads: make(map[string]*fakeUpload),
+	}
+}
+
+// put stores an object
+func (f *fakeServer) put(path string, body []byte, header http.Header) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.store(path, body, header, md5ETag(body))
+}
+
+// get returns a stored object
+func (f *fakeServer) get(path string) *fakeObject {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.objects[path]
+}
+
+// lastHeader returns the header of the last request
+func (f *fakeServer) lastHeader() http.Header {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.header
+}
+
+// count returns the number of handled requests starting with prefix,
+// e.g. "PUT" or "POST /key?uploads"
+func (f *fakeServer) count(prefix string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := 0
+	for _, r := range f.requests {
+		if strings.HasPrefix(r, prefix) {
+			n++
+		}
+	}
+	return n
+}
+
+func (f *fakeServer) store(path string, body []byte, header http.Header, etag string) {
+	h := make(http.Header)
+	for k, vv := range header {
+		if storedHeader(k) {
+			h[k] = vv
+		}
+	}
+	h.Set("ETag", `"`+etag+`"`)
+	h.Set("Last-Modified", "Wed, 12 Oct 2009 17:50:00 GMT")
+	if h.Get("Content-Type") == "" {
+		h.Set("Content-Type", "binary/octet-stream")
+	}
+	f.objects[path] = &fakeObject{header: h, body: body}
+}
+
+// storedHeader reports if header k is persisted with the object
+func storedHeader(k string) bool {
+	k = http.CanonicalHeaderKey(k)
+	switch k {
+	case "Content-Type", "Cache-Control", "Content-Disposition", "Content-Encoding",
+		"Content-Language", "Expires":
+		return true
+	}
+	return strings.HasPrefix(k, "X-Amz-Meta-") ||
+		strings.HasPrefix(k, "X-Amz-Server-Side-Encryption") ||
+		strings.HasPrefix(k, "X-Amz-Storage-Class") ||
+		strings.HasPrefix(k, "X-Amz-Checksum-")
+}
+
+func (f *fakeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.requests = append(f.requests, r.Method+" "+r.URL.RequestURI())
+	f.header = r.Header
+	if r.Header.Get("Authorization") == "" {
+		f.error(w, 403, "AccessDenied")
+		return
+	}
+
+	q := r.URL.Query()
+	path := r.URL.Path
+
+	if v := r.Header.Get("Content-Md5"); v != "" && v != contentMD5(body) {
+		f.error(w, 400, "BadDigest")
+		return
+	}
+
+	switch {
+	case r.Method == "POST" && has(q, "uploads"):
+		f.nextId++
+		id := strconv.Itoa(f.nextId)
+		f.uploads[id] = &fakeUpload{path: path, header: r.Header, parts: make(map[int][]byte), initiated: time.Now()}
+		fmt.Fprintf(w, `<InitiateMultipartUploadResult><UploadId>%s</UploadId></InitiateMultipartUploadResult>`, id)
+
+	case r.Method == "PUT" && has(q, "uploadId"):
+		u, ok := f.uploads[q.Get("uploadId")]
+		if !ok {
+			f.error(w, 404, "NoSuchUpload")
+			return
+		}
+		n, _ := strconv.Atoi(q.Get("partNumber"))
+		if v := r.Header.Get("X-Amz-Copy-Source"); v != "" {
+			src, _ := url.PathUnescape(v)
+			o, ok := f.objects[strings.TrimPrefix(src, "/bucket")]
+			if !ok {
+				f.error(w, 404, "NoSuchKey")
+				return
+			}
+			var start, end int
+			fmt.Sscanf(r.Header.Get("X-Amz-Copy-Source-Range"), "bytes=%d-%d", &start, &end)
+			if end >= len(o.body) || start > end {
+				f.error(w, 416, "InvalidRange")
+				return
+			}
+			u.parts[n] = o.body[start : end+1]
+			fmt.Fprintf(w, `<CopyPartResult><ETag>"%s"</ETag></CopyPartResult>`, md5ETag(u.parts[n]))
+			return
+		}
+		u.parts[n] = body
+		w.Header().Set("ETag", `"`+md5ETag(body)+`"`)
+
+	case r.Method == "POST" && has(q, "uploadId"):
+		id := q.Get("uploadId")
+		u, ok := f.uploads[id]
+		if !ok {
+			f.error(w, 404, "NoSuchUpload")
+			return
+		}
+		var complete struct {
+			Part []struct {
+				PartNumber int
+				ETag       string
+			}
+		}
+		if err := xml.Unmarshal(body, &complete); err != nil {
+			f.error(w, 400, "MalformedXML")
+			return
+		}
+		var data []byte
+		var sums []byte
+		for i, p := range complete.Part {
+			b, ok := u.parts[p.PartNumber]
+			if !ok || p.PartNumber != i+1 || strings.Trim(p.ETag, `"`) != md5ETag(b) {
+				f.error(w, 400, "InvalidPart")
+				return
+			}
+			data = append(data, b...)
+			sum := md5.Sum(b)
+			sums = append(sums, sum[:]...)
+		}
+		if f.preconditionFailed(w, r, u.path) {
+			return
+		}
+		sum := md5.Sum(sums)
+		f.store(u.path, data, u.header, fmt.Sprintf("%x-%d", sum, len(complete.Part)))
+		for _, p := range complete.Part {
+			f.objects[u.path].parts = append(f.objects[u.path].parts, len(u.parts[p.PartNumber]))
+		}
+		delete(f.uploads, id)
+		fmt.Fprint(w, `<CompleteMultipartUploadResult></CompleteMultipartUploadResult>`)
+
+	case r.Method == "GET" && has(q, "list-type"):
+		f.listObjects(w, q)
+
+	case r.Method == "POST" && has(q, "delete"):
+		var d deleteRequest
+		if err := xml.Unmarshal(body, &d); err != nil {
+			f.error(w, 400, "MalformedXML")
+			return
+		}
+		var result deleteResult
+		for _, o := range d.Object {
+			delete(f.objects, "/"+o.Key)
+			if !d.Quiet {
+				result.Deleted = append(result.Deleted, o)
+			}
+		}
+		xml.NewEncoder(w).Encode(struct {
+			XMLName xml.Name `xml:"DeleteResult"`
+			deleteResult
+		}{deleteResult: result})
+
+	case r.Method == "GET" && has(q, "uploads"):
+		f.listUploads(w, q)
+
+	case r.Method == "GET" && has(q, "uploadId"):
+		u, ok := f.uploads[q.Get("uploadId")]
+		if !ok {
+			f.error(w, 404, "NoSuchUpload")
+			return
+		}
+		f.listParts(w, u, q)
+
+	case r.Method == "DELETE" && has(q, "uploadId"):
+		delete(f.uploads, q.Get("uploadId"))
+		w.WriteHeader(204)
+
+	case r.Method == "PUT" && r.Header.Get("X-Amz-Copy-Source") != "":
+		src, _ := url.PathUnescape(r.Header.Get("X-Amz-Copy-Source"))
+		o, ok := f.objects[strings.TrimPrefix(src, "/bucket")]
+		if !ok {
+			f.error(w, 404, "NoSuchKey")
+			return
+		}
+		header := o.header
+		if r.Header.Get("X-Amz-Metadata-Directive") == "REPLACE" {
+			header = r.Header
+		}
+		f.store(path, o.body, header, md5ETag(o.body))
+		fmt.Fprintf(w, `<CopyObjectResult><ETag>"%s"</ETag></CopyObjectResult>`, md5ETag(o.body))
+
+	case r.Method == "PUT":
+		if f.preconditionFailed(w, r, path) {
+			return
+		}
+		f.store(path, body, r.Header, md5ETag(body))
+
+	case r.Method == "GET" && has(q, "attributes"):
+		o, ok := f.objects[path]
+		if !ok {
+			f.error(w, 404, "NoSuchKey")
+			return
+		}
+		f.attributes(w, o, strings.Split(r.Header.Get("X-Amz-Object-Attributes"), ","))
+
+	case r.Method == "GET" || r.Method == "HEAD":
+		o, ok := f.objects[path]
+		if !ok {
+			f.error(w, 404, "NoSuchKey")
+			return
+		}
+		if v := r.Header.Get("If-Match"); v != "" && v != o.header.Get("ETag") {
+			f.error(w, 412, "PreconditionFailed")
+			return
+		}
+		for k, vv := range o.header {
+			w.Header()[k] = vv
+		}
+		b := o.body
+		var start, end int
+		if n, _ := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-%d", &start, &end); n > 0 {
+			if n == 1 || end >= len(b) {
+				end = len(b) - 1
+			}
+			if start >= len(b) {
+				f.error(w, 416, "InvalidRange")
+				return
+			}
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(b)))
+			w.Header().Set("Content-Length", strconv.Itoa(end-start+1))
+			w.WriteHeader(206)
+			w.Write(b[start : end+1])
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(b)))
+		w.Write(b)
+
+	case r.Method == "DELETE":
+		if f.preconditionFailed(w, r, path) {
+			return
+		}
+		delete(f.objects, path)
+		w.WriteHeader(204)
+
+	default:
+		f.error(w, 501, "NotImplemented")
+	}
+}
+
+// preconditionFailed responds with 412 if the If-Match or If-None-Match
+// header of a write to path is not met
+func (f *fakeServer) preconditionFailed(w http.ResponseWriter, r *http.Request, path string) bool {
+	o, ok := f.objects[path]
+	if v := r.Header.Get("If-Match"); v != "" && (!ok || v != o.header.Get("ETag")) ||
+		r.Header.Get("If-None-Match") == "*" && ok {
+		f.error(w, 412, "PreconditionFailed")
+		return true
+	}
+	return false
+}
+
+// startUpload creates a multipart upload initiated at the specified time and
+// returns its id
+func (f *fakeServer) startUpload(path string, initiated time.Time) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nextId++
+	id := strconv.Itoa(f.nextId)
+	f.uploads[id] = &fakeUpload{path: path, header: make(http.Header), parts: make(map[int][]byte), initiated: initiated}
+	return id
+}
+
+func (f *fakeServer) limit() int {
+	if f.pageSize > 0 {
+		return f.pageSize
+	}
+	return 1000
+}
+
+func (f *fakeServer) listUploads(w http.ResponseWriter, q url.Values) {
+	type upload struct {
+		Key       string
+		UploadId  string
+		Initiated time.Time
+	}
+	var all []upload
+	for id, u := range f.uploads {
+		key := strings.TrimPrefix(u.path, "/")
+		if strings.HasPrefix(key, q.Get("prefix")) {
+			all = append(all, upload{key, id, u.initiated})
+		}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Key != all[j].Key {
+			return all[i].Key < all[j].Key
+		}
+		return all[i].UploadId < all[j].UploadId
+	})
+
+	var result struct {
+		XMLName            xml.Name `xml:"ListMultipartUploadsResult"`
+		IsTruncated        bool
+		NextKeyMarker      string
+		NextUploadIdMarker string
+		Upload             []upload
+	}
+	marker, idMarker := q.Get("key-marker"), q.Get("upload-id-marker")
+	for _, u := range all {
+		if u.Key < marker || u.Key == marker && u.UploadId <= idMarker {
+			continue
+		}
+		if len(result.Upload) == f.limit() {
+			result.IsTruncated = true
+			break
+		}
+		result.Upload = append(result.Upload, u)
+		result.NextKeyMarker, result.NextUploadIdMarker = u.Key, u.UploadId
+	}
+	xml.NewEncoder(w).Encode(result)
+}
+
+func (f *fakeServer) listObjects(w http.ResponseWriter, q url.Values) {
+	type content struct {
+		Key  string
+		Size int
+		ETag string
+	}
+	type commonPrefix struct {
+		Prefix string
+	}
+	var result struct {
+		XMLName        xml.Name `xml:"ListBucketResult"`
+		Contents       []content
+		CommonPrefixes []commonPrefix
+	}
+	prefix, delim := q.Get("prefix"), q.Get("delimiter")
+	seen := make(map[string]bool)
+	for path, o := range f.objects {
+		key := strings.TrimPrefix(path, "/")
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if i := strings.Index(key[len(prefix):], delim); delim != "" && i >= 0 {
+			p := key[:len(prefix)+i+len(delim)]
+			if !seen[p] {
+				seen[p] = true
+				result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{p})
+			}
+			continue
+		}
+		result.Contents = append(result.Contents, content{key, len(o.body), o.header.Get("ETag")})
+	}
+	sort.Slice(result.Contents, func(i, j int) bool {
+		return result.Contents[i].Key < result.Contents[j].Key
+	})
+	sort.Slice(result.CommonPrefixes, func(i, j int) bool {
+		return result.CommonPrefixes[i].Prefix < result.CommonPrefixes[j].Prefix
+	})
+	xml.NewEncoder(w).Encode(result)
+}
+
+func (f *fakeServer) listParts(w http.ResponseWriter, u *fakeUpload, q url.Values) {
+	var numbers []int
+	marker, _ := strconv.Atoi(q.Get("part-number-marker"))
+	for n := range u.parts {
+		if n > marker {
+			numbers = append(numbers, n)
+		}
+	}
+	sort.Ints(numbers)
+
+	var result struct {
+		XMLName              xml.Name `xml:"ListPartsResult"`
+		IsTruncated          bool
+		NextPartNumberMarker int
+		Part                 []UploadedPart
+	}
+	for _, n := range numbers {
+		if len(result.Part) == f.limit() {
+			result.IsTruncated = true
+			break
+		}
+		b := u.parts[n]
+		result.Part = append(result.Part, UploadedPart{
+			PartNumber: n,
+			ETag:       `"` + md5ETag(b) + `"`,
+			Size:       int64(len(b)),
+		})
+		result.NextPartNumberMarker = n
+	}
+	xml.NewEncoder(w).Encode(result)
+}
+
+func (f *fakeServer) attributes(w http.ResponseWriter, o *fakeObject, fields []string) {
+	type part struct {
+		PartNumber int
+		Size       int
+	}
+	var result struct {
+		XMLName     xml.Name `xml:"GetObjectAttributesResponse"`
+		ETag        string   `xml:",omitempty"`
+		ObjectSize  int      `xml:",omitempty"`
+		ObjectParts *struct {
+			TotalPartsCount int
+			Part            []part
+		}
+	}
+	for _, k := range fields {
+		switch k {
+		case "ETag":
+			result.ETag = o.header.Get("ETag")
+		case "ObjectSize":
+			result.ObjectSize = len(o.body)
+		case "ObjectParts":
+			if len(o.parts) > 0 {
+				result.ObjectParts = &struct {
+					TotalPartsCount int
+					Part            []part
+				}{TotalPartsCount: len(o.parts)}
+				for i, n := range o.parts {
+					result.ObjectParts.Part = append(result.ObjectParts.Part, part{i + 1, n})
+				}
+			}
+		}
+	}
+	xml.NewEncoder(w).Encode(result)
+}
+
+func (f *fakeServer) error(w http.ResponseWriter, code int, s3code string) {
+	w.WriteHeader(code)
+	fmt.Fprintf(w, `<Error><Code>%s</Code><Message>%s</Message></Error>`, s3code, s3code)
+}
+
+func has(q map[string][]string, k string) bool {
+	_, ok := q[k]
+	return ok
+}
+
+func md5ETag(b []byte) string {
+	sum := md5.Sum(b)
+	return hex.EncodeToString(sum[:])
+}
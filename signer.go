@@ -0,0 +1,145 @@
+package s3
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Signer generates presigned URLs for objects of a bucket. Unlike
+// ExpiringURL, the base URL and the signing key are computed once, which makes
+// generating many URLs cheaper. A Signer is safe for concurrent use.
+type Signer struct {
+	s3   S3
+	base url.URL
+
+	// resource is the escaped V2 canonical resource prefix of keys
+	resource string
+
+	// hashes pools the V2 HMACs
+	hashes sync.Pool
+
+	// the V4 signing key of day
+	mu  sync.Mutex
+	day string
+	key []byte
+}
+
+// NewSigner returns a Signer for the objects of the bucket. If a credentials
+// provider is configured, the credentials are retrieved once, so a new
+// Signer is needed when they expire.
+func (s3 *S3) NewSigner() (*Signer, error) {
+	if err := s3.checkConfig(); err != nil {
+		return nil, err
+	}
+	s3, err := s3.resolve(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(s3.publicURL())
+	if err != nil {
+		return nil, err
+	}
+	s3.clockSkew()
+	s := &Signer{s3: *s3, base: *u, resource: `/` + escapePath(s3.Bucket) + `/`}
+	s.hashes.New = func() interface{} {
+		return hmac.New(sha1.New, []byte(s.s3.Secret))
+	}
+	return s, nil
+}
+
+// PresignGet returns a URL to download the object with the specified key,
+// which is valid for expiresIn. The key is relative to the configured Path,
+// like the key of Object.
+func (s *Signer) PresignGet(key string, expiresIn time.Duration) (*url.URL, error) {
+	key = trim(key)
+	if p := trim(s.s3.Path); p != "" {
+		key = p + `/` + key
+	}
+	key = escapePath(key)
+
+	u := s.base
+	u.RawPath = u.EscapedPath() + `/` + key
+	path, err := url.PathUnescape(u.RawPath)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = path
+
+	t := s.s3.now()
+	if s.s3.signV4() {
+		if expiresIn > maxPresignV4 {
+			return nil, errors.New("s3: presigned URLs can't be valid for more than 7 days")
+		}
+		u.RawQuery = s.queryV4(&u, t, expiresIn)
+		return &u, nil
+	}
+	u.RawQuery = s.queryV2(key, t, expiresIn)
+	return &u, nil
+}
+
+// queryV2 returns the V2 query string authentication parameters
+func (s *Signer) queryV2(key string, t time.Time, expiresIn time.Duration) string {
+	expires := strconv.FormatInt(t.Add(expiresIn).Unix(), 10)
+	amz := ""
+	if s.s3.Token != "" {
+		amz = "x-amz-security-token:" + s.s3.Token + "\n"
+	}
+
+	h := s.hashes.Get().(hash.Hash)
+	h.Reset()
+	h.Write([]byte("GET\n\n\n" + expires + "\n" + amz + s.resource + key))
+	sig := base64.StdEncoding.EncodeToString(h.Sum(nil))
+	s.hashes.Put(h)
+
+	// sorted like url.Values.Encode
+	q := "AWSAccessKeyId=" + url.QueryEscape(s.s3.AccessKey) +
+		"&Expires=" + expires +
+		"&Signature=" + url.QueryEscape(sig)
+	if s.s3.Token != "" {
+		q += "&x-amz-security-token=" + url.QueryEscape(s.s3.Token)
+	}
+	return q
+}
+
+// queryV4 returns the V4 query string authentication parameters
+func (s *Signer) queryV4(u *url.URL, t time.Time, expiresIn time.Duration) string {
+	t = t.UTC()
+	scope := s.s3.scopeV4(t)
+
+	// sorted by name
+	var q strings.Builder
+	q.WriteString("X-Amz-Algorithm=" + v4Algorithm)
+	q.WriteString("&X-Amz-Credential=" + escape(s.s3.AccessKey+`/`+scope))
+	q.WriteString("&X-Amz-Date=" + t.Format(v4TimeFormat))
+	q.WriteString("&X-Amz-Expires=" + strconv.FormatInt(int64(expiresIn/time.Second), 10))
+	if s.s3.Token != "" {
+		q.WriteString("&X-Amz-Security-Token=" + escape(s.s3.Token))
+	}
+	q.WriteString("&X-Amz-SignedHeaders=host")
+
+	creq := "GET\n" + canonicalURIV4(u.Path) + "\n" + q.String() + "\nhost:" + u.Host + "\n\nhost\n" + unsignedPayload
+	sts := stringToSignV4(t, scope, creq)
+	sig := hex.EncodeToString(hmacSHA256(s.signingKeyV4(t), sts))
+	return q.String() + "&X-Amz-Signature=" + sig
+}
+
+// signingKeyV4 returns the V4 signing key of the day of t, which is cached
+func (s *Signer) signingKeyV4(t time.Time) []byte {
+	day := t.Format(v4DateFormat)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.day != day {
+		s.day, s.key = day, s.s3.signingKeyV4(t)
+	}
+	return s.key
+}
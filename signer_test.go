@@ -0,0 +1,73 @@
+package s3
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestSigner(t *testing.T) {
+	defer func(f func() time.Time) { now = f }(now)
+	now = func() time.Time { return time.Date(2015, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+	for _, s3 := range []*S3{
+		{Bucket: "bucket", AccessKey: "key", Secret: "secret"},
+		{Bucket: "bucket", AccessKey: "key", Secret: "secret", Token: "to+ken", Path: "dir"},
+		{Bucket: "my.bucket", AccessKey: "key", Secret: "secret", Region: "eu-west-1"},
+		{Bucket: "bucket", AccessKey: "key", Secret: "secret", SignatureVersion: 4, Region: "eu-central-1"},
+		{Bucket: "bucket", AccessKey: "key", Secret: "secret", SignatureVersion: 4, Token: "to/ken", PathStyle: true},
+		{Bucket: "bucket", AccessKey: "key", Secret: "secret", SignatureVersion: 4, CustomDomain: "cdn.example.com"},
+	} {
+		signer, err := s3.NewSigner()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, key := range []string{"file.txt", "/dir/a b+c ü.txt"} {
+			expected, err := s3.Object(key).ExpiringURL(time.Hour)
+			if err != nil {
+				t.Fatal(err)
+			}
+			u, err := signer.PresignGet(key, time.Hour)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if u.String() != expected.String() {
+				t.Fatalf("%s\n%s", u, expected)
+			}
+		}
+	}
+
+	s3 := &S3{Bucket: "bucket", SignatureVersion: 4}
+	signer, _ := s3.NewSigner()
+	if _, err := signer.PresignGet("key", 8*24*time.Hour); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func BenchmarkExpiringURL(b *testing.B) {
+	for _, v := range []int{2, 4} {
+		s3 := &S3{Bucket: "bucket", AccessKey: "key", Secret: "secret", SignatureVersion: v}
+		b.Run(fmt.Sprintf("V%d", v), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				s3.Object("dir/file.txt").ExpiringURL(time.Hour)
+			}
+		})
+	}
+}
+
+func BenchmarkSigner(b *testing.B) {
+	for _, v := range []int{2, 4} {
+		s3 := &S3{Bucket: "bucket", AccessKey: "key", Secret: "secret", SignatureVersion: v}
+		signer, err := s3.NewSigner()
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("V%d", v), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				signer.PresignGet("dir/file.txt", time.Hour)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package s3
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	v4Algorithm  = `AWS4-HMAC-SHA256`
+	v4TimeFormat = `20060102T150405Z`
+	v4DateFormat = `20060102`
+	v4Service    = `s3`
+	v4Terminator = `aws4_request`
+
+	// sha256 of the empty string
+	emptyPayloadHash = `e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855`
+	unsignedPayload  = `UNSIGNED-PAYLOAD`
+
+	defaultRegion = `us-east-1`
+
+	// maxPresignV4 is the longest validity of a V4 presigned URL
+	maxPresignV4 = 7 * 24 * time.Hour
+)
+
+// headers which are never part of the V4 signature
+var v4IgnoredHeaders = map[string]bool{
+	"authorization":  true,
+	"user-agent":     true,
+	"content-length": true,
+	"expect":         true,
+}
+
+// http://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func (s3 *S3) signRequestV4(req *http.Request, t time.Time) {
+	t = t.UTC()
+	req.Header.Set("X-Amz-Date", t.Format(v4TimeFormat))
+	if req.Header.Get("X-Amz-Content-Sha256") == "" {
+		req.Header.Set("X-Amz-Content-Sha256", payloadHash(req))
+	}
+
+	creq, signedHeaders := canonicalRequestV4(req, req.Header.Get("X-Amz-Content-Sha256"))
+	scope := s3.scopeV4(t)
+	sts := stringToSignV4(t, scope, creq)
+	if s3.DebugSignature != nil {
+		s3.DebugSignature(req, sts)
+	}
+	sig := s3.signatureV4(t, sts)
+
+	req.Header.Set("Authorization", v4Algorithm+
+		" Credential="+s3.AccessKey+`/`+scope+
+		",SignedHeaders="+signedHeaders+
+		",Signature="+sig)
+}
+
+// presignV4 adds the V4 query string authentication parameters to u, for a
+// request with the specified method and header, valid for expiresIn starting
+// at t. The header may be nil.
+//
+// http://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html
+func (s3 *S3) presignV4(method string, u *url.URL, h http.Header, t time.Time, expiresIn time.Duration) error {
+	if expiresIn > maxPresignV4 {
+		return errors.New("s3: presigned URLs can't be valid for more than 7 days")
+	}
+	t = t.UTC()
+	scope := s3.scopeV4(t)
+
+	if h == nil {
+		h = make(http.Header)
+	}
+	req := &http.Request{Method: method, URL: u, Host: u.Host, Header: h}
+	_, signedHeaders := canonicalRequestV4(req, unsignedPayload)
+
+	q := u.Query()
+	q.Set("X-Amz-Algorithm", v4Algorithm)
+	q.Set("X-Amz-Credential", s3.AccessKey+`/`+scope)
+	q.Set("X-Amz-Date", t.Format(v4TimeFormat))
+	q.Set("X-Amz-Expires", strconv.FormatInt(int64(expiresIn/time.Second), 10))
+	q.Set("X-Amz-SignedHeaders", signedHeaders)
+	if s3.Token != "" {
+		q.Set("X-Amz-Security-Token", s3.Token)
+	}
+	u.RawQuery = canonicalQueryV4(q)
+
+	creq, _ := canonicalRequestV4(req, unsignedPayload)
+	sig := s3.signatureV4(t, stringToSignV4(t, scope, creq))
+
+	u.RawQuery += "&X-Amz-Signature=" + sig
+	return nil
+}
+
+// canonicalRequestV4 returns the canonical request and the list of signed headers
+func canonicalRequestV4(req *http.Request, payloadHash string) (creq, signedHeaders string) {
+	// canonicalize headers
+	hv := make(map[string][]string)
+	hv["host"] = []string{requestHost(req)}
+	for k, vv := range req.Header {
+		k = strings.ToLower(k)
+		if v4IgnoredHeaders[k] || k == "host" {
+			continue
+		}
+		hv[k] = append(hv[k], vv...)
+	}
+
+	names := make([]string, 0, len(hv))
+	for k := range hv {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	headers := make([]string, len(names))
+	for i, k := range names {
+		vv := make([]string, len(hv[k]))
+		for j, v := range hv[k] {
+			vv[j] = strings.Join(strings.Fields(v), ` `)
+		}
+		headers[i] = k + `:` + strings.Join(vv, `,`) + "\n"
+	}
+	signedHeaders = strings.Join(names, `;`)
+
+	return strings.Join([]string{
+		req.Method,
+		canonicalURIV4(req.URL.Path),
+		canonicalQueryV4(req.URL.Query()),
+		strings.Join(headers, ""),
+		signedHeaders,
+		payloadHash,
+	}, "\n"), signedHeaders
+}
+
+func canonicalURIV4(path string) string {
+	if path == "" {
+		return `/`
+	}
+	return escapePath(path)
+}
+
+// canonicalQueryV4 returns the escaped parameters sorted by key, then by
+// value. Sorting the joined pairs would be wrong, e.g. "select-type=2"
+// sorts before "select=".
+func canonicalQueryV4(query map[string][]string) string {
+	type param struct{ k, v string }
+	params := make([]param, 0, len(query))
+	for k, vv := range query {
+		for _, v := range vv {
+			params = append(params, param{escape(k), escape(v)})
+		}
+	}
+	sort.Slice(params, func(i, j int) bool {
+		if params[i].k != params[j].k {
+			return params[i].k < params[j].k
+		}
+		return params[i].v < params[j].v
+	})
+	parts := make([]string, len(params))
+	for i, p := range params {
+		parts[i] = p.k + `=` + p.v
+	}
+	return strings.Join(parts, `&`)
+}
+
+func stringToSignV4(t time.Time, scope, creq string) string {
+	h := sha256.Sum256([]byte(creq))
+	return strings.Join([]string{
+		v4Algorithm,
+		t.UTC().Format(v4TimeFormat),
+		scope,
+		hex.EncodeToString(h[:]),
+	}, "\n")
+}
+
+func (s3 *S3) scopeV4(t time.Time) string {
+	return strings.Join([]string{
+		t.UTC().Format(v4DateFormat),
+		s3.signingRegion(),
+		v4Service,
+		v4Terminator,
+	}, `/`)
+}
+
+func (s3 *S3) signingRegion() string {
+	if ap, ok := s3.accessPoint(); ok {
+		return ap.region
+	}
+	if s3.Region == "" {
+		return defaultRegion
+	}
+	return s3.Region
+}
+
+// signatureV4 signs s with the key derived from the date, region and service
+func (s3 *S3) signatureV4(t time.Time, s string) string {
+	return hex.EncodeToString(hmacSHA256(s3.signingKeyV4(t), s))
+}
+
+// signingKeyV4 derives the signing key of the day of t
+func (s3 *S3) signingKeyV4(t time.Time) []byte {
+	k := hmacSHA256([]byte("AWS4"+s3.Secret), t.UTC().Format(v4DateFormat))
+	k = hmacSHA256(k, s3.signingRegion())
+	k = hmacSHA256(k, v4Service)
+	return hmacSHA256(k, v4Terminator)
+}
+
+func hmacSHA256(key []byte, s string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(s))
+	return h.Sum(nil)
+}
+
+// payloadHash returns the hex encoded sha256 of the request body. If the body
+// can't be read without consuming it, the payload is left unsigned.
+func payloadHash(req *http.Request) string {
+	if req.Body == nil || req.Body == http.NoBody {
+		return emptyPayloadHash
+	}
+	if req.GetBody == nil {
+		return unsignedPayload
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return unsignedPayload
+	}
+	defer body.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, body); err != nil {
+		return unsignedPayload
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func requestHost(req *http.Request) string {
+	if req.Host != "" {
+		return req.Host
+	}
+	return req.URL.Host
+}
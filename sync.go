@@ -0,0 +1,133 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// SyncOptions holds optional settings for SyncDir
+type SyncOptions struct {
+	// Delete removes objects below the key prefix that have no local file
+	Delete bool
+
+	// Upload applies to the uploaded objects. If ContentType is empty, it is
+	// detected from the file extension.
+	Upload UploadOptions
+}
+
+// SyncResult lists the keys handled by SyncDir, relative to the configured
+// Path
+type SyncResult struct {
+	Uploaded []string
+	Skipped  []string
+	Deleted  []string
+}
+
+// SyncDir uploads the files below localDir to keys below keyPrefix. Files are
+// skipped if an object with the same size and MD5 ETag exists, which is not
+// the case for objects uploaded in multiple parts. If opts.Delete is set,
+// objects below keyPrefix without a local file are deleted.
+func (s3 *S3) SyncDir(localDir, keyPrefix string, opts SyncOptions) (SyncResult, error) {
+	var result SyncResult
+
+	prefix := strings.Trim(keyPrefix, `/`)
+	if prefix != "" {
+		prefix += `/`
+	}
+	remote := make(map[string]ObjectInfo)
+	objects, err := s3.List(prefix)
+	if err != nil {
+		return result, err
+	}
+	for _, o := range objects {
+		remote[o.Key] = o
+	}
+
+	local := make(map[string]bool)
+	err = filepath.Walk(localDir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil || !fi.Mode().IsRegular() {
+			return err
+		}
+		rel, err := filepath.Rel(localDir, path)
+		if err != nil {
+			return err
+		}
+		key := prefix + filepath.ToSlash(rel)
+		local[key] = true
+
+		if o, ok := remote[key]; ok && o.Size == fi.Size() {
+			sum, err := fileMD5(path)
+			if err != nil {
+				return err
+			}
+			if sum == o.ETag {
+				result.Skipped = append(result.Skipped, key)
+				return nil
+			}
+		}
+		if err := s3.uploadFile(path, key, opts.Upload); err != nil {
+			return err
+		}
+		result.Uploaded = append(result.Uploaded, key)
+		return nil
+	})
+	if err != nil || !opts.Delete {
+		return result, err
+	}
+
+	var deleted []string
+	for key := range remote {
+		if !local[key] {
+			deleted = append(deleted, key)
+		}
+	}
+	if len(deleted) == 0 {
+		return result, nil
+	}
+	sort.Strings(deleted)
+	failed, err := s3.DeleteMultipleQuiet(deleted)
+	if err != nil {
+		return result, err
+	}
+	if len(failed) > 0 {
+		return result, failed[0].Err
+	}
+	result.Deleted = deleted
+	return result, nil
+}
+
+// uploadFile uploads the file at path to key
+func (s3 *S3) uploadFile(path, key string, opts UploadOptions) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := s3.Object(key).WriterWithOptions(opts)
+	if _, err := io.Copy(w, f); err != nil {
+		w.Abort()
+		return err
+	}
+	return w.Close()
+}
+
+// fileMD5 returns the hex encoded MD5 of the file at path
+func fileMD5(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := md5.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
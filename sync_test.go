@@ -0,0 +1,71 @@
+package s3
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSyncDir(t *testing.T) {
+	s3, f := newFakeS3(t)
+	dir := t.TempDir()
+	write := func(name, data string) {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("index.html", "<html>")
+	write("css/site.css", "body {}")
+	write("unchanged.txt", "same")
+	f.put("/site/unchanged.txt", []byte("same"), nil)
+	f.put("/site/changed.txt", []byte("old"), nil)
+	write("changed.txt", "new")
+	f.put("/site/removed.txt", []byte("gone"), nil)
+	f.put("/site2/other.txt", []byte("other"), nil)
+
+	result, err := s3.SyncDir(dir, "/site/", SyncOptions{Delete: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := strings.Join(result.Uploaded, ","); x != "site/changed.txt,site/css/site.css,site/index.html" {
+		t.Fatal(x)
+	}
+	if x := strings.Join(result.Skipped, ","); x != "site/unchanged.txt" {
+		t.Fatal(x)
+	}
+	if x := strings.Join(result.Deleted, ","); x != "site/removed.txt" {
+		t.Fatal(x)
+	}
+
+	if o := f.get("/site/index.html"); o == nil || string(o.body) != "<html>" || o.header.Get("Content-Type") != "text/html" {
+		t.Fatal(o)
+	}
+	if o := f.get("/site/css/site.css"); o == nil || o.header.Get("Content-Type") != "text/css" {
+		t.Fatal(o)
+	}
+	if o := f.get("/site/changed.txt"); string(o.body) != "new" {
+		t.Fatal(string(o.body))
+	}
+	if f.get("/site/removed.txt") != nil || f.get("/site2/other.txt") == nil {
+		t.Fatal("wrong objects deleted")
+	}
+	if f.count("PUT /site/unchanged.txt") != 0 {
+		t.Fatal(f.requests)
+	}
+
+	// without Delete, remote objects are kept
+	f.put("/site/removed.txt", []byte("gone"), nil)
+	result, err = s3.SyncDir(dir, "site", SyncOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Uploaded) != 0 || len(result.Skipped) != 4 || f.get("/site/removed.txt") == nil {
+		t.Fatal(result)
+	}
+}
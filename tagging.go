@@ -0,0 +1,92 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"sort"
+	"strings"
+)
+
+type tagging struct {
+	XMLName xml.Name `xml:"Tagging"`
+	TagSet  struct {
+		Tag []tag
+	}
+}
+
+type tag struct {
+	Key   string
+	Value string
+}
+
+func (o *object) Tags() (map[string]string, error) {
+	req, err := o.newRequest(context.Background(), "GET", "?tagging", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := o.s3.send(req, 200, "error getting tags")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var t tagging
+	if err := xml.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string, len(t.TagSet.Tag))
+	for _, v := range t.TagSet.Tag {
+		tags[v.Key] = v.Value
+	}
+	return tags, nil
+}
+
+func (o *object) SetTags(tags map[string]string) error {
+	b, err := marshalTags(tags)
+	if err != nil {
+		return err
+	}
+
+	req, err := o.newRequest(context.Background(), "PUT", "?tagging", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-MD5", contentMD5(b))
+
+	resp, err := o.s3.send(req, 200, "error setting tags")
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// marshalTags returns the Tagging XML document for tags, sorted by key
+func marshalTags(tags map[string]string) ([]byte, error) {
+	var t tagging
+	for _, k := range sortedKeys(tags) {
+		t.TagSet.Tag = append(t.TagSet.Tag, tag{Key: k, Value: tags[k]})
+	}
+	return xml.Marshal(t)
+}
+
+// encodeTags returns tags URL-encoded for the x-amz-tagging header
+func encodeTags(tags map[string]string) string {
+	parts := make([]string, 0, len(tags))
+	for _, k := range sortedKeys(tags) {
+		parts = append(parts, escape(k)+`=`+escape(tags[k]))
+	}
+	return strings.Join(parts, `&`)
+}
+
+func sortedKeys(m map[string]string) []string {
+	a := make([]string, 0, len(m))
+	for k := range m {
+		a = append(a, k)
+	}
+	sort.Strings(a)
+	return a
+}
@@ -0,0 +1,84 @@
+package s3
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestMarshalTags(t *testing.T) {
+	b, err := marshalTags(map[string]string{"b": "x & y", "a": "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `<Tagging><TagSet><Tag><Key>a</Key><Value>1</Value></Tag><Tag><Key>b</Key><Value>x &amp; y</Value></Tag></TagSet></Tagging>`
+	if x := string(b); x != expected {
+		t.Fatal(x)
+	}
+
+	b, err = marshalTags(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := string(b); x != `<Tagging><TagSet></TagSet></Tagging>` {
+		t.Fatal(x)
+	}
+
+	if x := encodeTags(map[string]string{"project": "a b", "cost/center": "x&y=z"}); x != "cost%2Fcenter=x%26y%3Dz&project=a%20b" {
+		t.Fatal(x)
+	}
+}
+
+func TestTags(t *testing.T) {
+	var stored []byte
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.URL.Query()["tagging"]; !ok {
+			t.Error(r.URL)
+		}
+		switch r.Method {
+		case "PUT":
+			if r.Header.Get("Content-MD5") == "" {
+				t.Error("missing md5")
+			}
+			stored, _ = ioutil.ReadAll(r.Body)
+		case "GET":
+			if stored == nil {
+				stored = []byte(`<Tagging><TagSet></TagSet></Tagging>`)
+			}
+			w.Write(stored)
+		}
+	}))
+	o := s3.Object("key")
+
+	// empty
+	tags, err := o.Tags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tags == nil || len(tags) != 0 {
+		t.Fatal(tags)
+	}
+
+	// round trip
+	in := map[string]string{"project": "a & b", "env": "<prod>"}
+	if err := o.SetTags(in); err != nil {
+		t.Fatal(err)
+	}
+	tags, err = o.Tags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 2 || tags["project"] != "a & b" || tags["env"] != "<prod>" {
+		t.Fatal(tags)
+	}
+
+	// on upload
+	s3, f := newFakeS3(t)
+	if err := s3.Object("key").Put(strings.NewReader("x"), 1, WithTags(map[string]string{"a": "b c"})); err != nil {
+		t.Fatal(err)
+	}
+	if x := f.lastHeader().Get("X-Amz-Tagging"); x != "a=b%20c" {
+		t.Fatal(x)
+	}
+}
@@ -2,15 +2,21 @@ package s3
 
 import (
 	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"net/url"
 	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
 const (
@@ -23,11 +29,234 @@ const (
 const (
 	nConcurrentUploads = 5
 	nRetries           = 2
+
+	// abortTimeout bounds the request that aborts a multipart upload
+	abortTimeout = 30 * time.Second
+)
+
+// UploadOptions holds optional settings for uploads
+type UploadOptions struct {
+	// ContentType is the content type of the object. If empty, it is
+	// detected from the key extension, or from the first 512 bytes of the
+	// data if the extension is unknown.
+	ContentType string
+
+	// NoSniff disables detecting the content type from the data
+	NoSniff bool
+
+	// CacheControl sets the Cache-Control header of the object
+	CacheControl string
+
+	// ContentDisposition sets the Content-Disposition header of the object
+	ContentDisposition string
+
+	// ContentEncoding sets the Content-Encoding header of the object, e.g.
+	// "br" for data that is already compressed. ContentType should be set
+	// too, since it can't be detected from encoded data. If Gzip is set,
+	// gzip is applied on top, e.g. "br, gzip".
+	ContentEncoding string
+
+	// Gzip compresses the data while it is uploaded and adds gzip to the
+	// Content-Encoding header. Size and Progress refer to the compressed
+	// data.
+	Gzip bool
+
+	// ACL is the canned ACL of the object. If empty, the bucket default
+	// applies.
+	ACL ACL
+
+	// Encryption requests server-side encryption of the object
+	Encryption Encryption
+
+	// KMSKeyId is the KMS key used for EncryptionKMS. If empty, the default
+	// key of the account is used.
+	KMSKeyId string
+
+	// Metadata is stored as x-amz-meta-* headers with the object. S3 stores
+	// keys in lower case.
+	Metadata map[string]string
+
+	// Tags is the tag set of the object
+	Tags map[string]string
+
+	// StorageClass is the storage class of the object. If empty, S3 uses
+	// StorageStandard.
+	StorageClass StorageClass
+
+	// PartSize is the size of the parts of a multipart upload. It must be at
+	// least MinPartSize. If 0, MinPartSize is used, or a larger size if
+	// required to upload Size bytes in MaxNumParts parts.
+	PartSize int64
+
+	// Size is the total size of the object if known, otherwise 0. It is used
+	// to verify the part size.
+	Size int64
+
+	// Checksum is an additional checksum algorithm. The checksum of the
+	// uploaded data is computed and verified by S3.
+	Checksum ChecksumAlgorithm
+
+	// Progress is called periodically with the number of uploaded bytes. The
+	// total is Size, or -1 if Size is 0.
+	Progress ProgressFunc
+
+	// SinglePutThreshold is the maximum size of objects which are uploaded
+	// with a single PUT request instead of a multipart upload. If 0,
+	// MinPartSize is used. If negative, multipart uploads are always used.
+	SinglePutThreshold int64
+
+	// Concurrency is the number of parts uploaded in parallel. If 0, 5 parts
+	// are uploaded concurrently. Each part in flight is held in memory.
+	Concurrency int
+
+	// Preconditions make the upload conditional. They are checked when the
+	// upload completes.
+	Preconditions Preconditions
+
+	// RateLimit limits the upload to RateLimit bytes per second, shared by
+	// the parts uploaded in parallel. If 0, the upload isn't limited.
+	RateLimit int64
+
+	// VerifyParts compares the ETag of each uploaded part with the MD5 of its
+	// data. On a mismatch the upload fails with an error wrapping
+	// ErrChecksumMismatch and is aborted. Parts encrypted with KMS or a
+	// customer-provided key are not verified, since their ETags aren't MD5
+	// sums.
+	VerifyParts bool
+}
+
+// singlePutThreshold returns the maximum size of single PUT uploads, or -1
+func (opts *UploadOptions) singlePutThreshold() int64 {
+	switch {
+	case opts.SinglePutThreshold < 0:
+		return -1
+	case opts.SinglePutThreshold == 0:
+		return MinPartSize
+	}
+	return opts.SinglePutThreshold
+}
+
+// concurrency returns the number of upload workers
+func (opts *UploadOptions) concurrency() int {
+	if opts.Concurrency > 0 {
+		return opts.Concurrency
+	}
+	return nConcurrentUploads
+}
+
+// partSize returns the validated part size of a multipart upload
+func (opts *UploadOptions) partSize() (int64, error) {
+	size := opts.PartSize
+	if size == 0 {
+		size = MinPartSize
+		if n := partCount(opts.Size, size); n > MaxNumParts {
+			size = (opts.Size + MaxNumParts - 1) / MaxNumParts
+		}
+	}
+	if size < MinPartSize || size > MaxPartSize {
+		return 0, fmt.Errorf("s3: part size must be between %d and %d bytes", MinPartSize, MaxPartSize)
+	}
+	if n := partCount(opts.Size, size); n > MaxNumParts {
+		return 0, fmt.Errorf("s3: uploading %d bytes in parts of %d bytes exceeds %d parts", opts.Size, size, MaxNumParts)
+	}
+	return size, nil
+}
+
+// partCount returns the number of parts needed to upload size bytes
+func partCount(size, partSize int64) int64 {
+	return (size + partSize - 1) / partSize
+}
+
+// StorageClass is the storage class of an object
+type StorageClass string
+
+const (
+	StorageStandard           StorageClass = "STANDARD"
+	StorageStandardIA         StorageClass = "STANDARD_IA"
+	StorageOneZoneIA          StorageClass = "ONEZONE_IA"
+	StorageIntelligentTiering StorageClass = "INTELLIGENT_TIERING"
+	StorageReducedRedundancy  StorageClass = "REDUCED_REDUNDANCY"
+	StorageGlacier            StorageClass = "GLACIER"
+	StorageDeepArchive        StorageClass = "DEEP_ARCHIVE"
 )
 
+// Encryption is a server-side encryption algorithm
+type Encryption string
+
+const (
+	EncryptionAES256 Encryption = "AES256"
+	EncryptionKMS    Encryption = "aws:kms"
+)
+
+// setHeaders sets the object headers on the upload request. data is the
+// start of the object, if known, and is used to detect the content type.
+func (opts *UploadOptions) setHeaders(h http.Header, key string, data []byte) {
+	contentType := opts.ContentType
+	if contentType == "" {
+		contentType = opts.detectContentType(key, data)
+	}
+	h.Set(`Content-Type`, contentType)
+
+	if v := opts.CacheControl; v != "" {
+		h.Set(`Cache-Control`, v)
+	}
+	if v := opts.ContentDisposition; v != "" {
+		h.Set(`Content-Disposition`, v)
+	}
+	// the encodings are listed in the order they were applied
+	if v := opts.ContentEncoding; v != "" && opts.Gzip {
+		h.Set(`Content-Encoding`, v+", gzip")
+	} else if v != "" {
+		h.Set(`Content-Encoding`, v)
+	} else if opts.Gzip {
+		h.Set(`Content-Encoding`, "gzip")
+	}
+	if v := opts.ACL; v != "" {
+		h.Set(`X-Amz-Acl`, string(v))
+	}
+	for k, v := range opts.Metadata {
+		h.Set(metaPrefix+strings.ToLower(k), v)
+	}
+	if v := opts.StorageClass; v != "" {
+		h.Set(`X-Amz-Storage-Class`, string(v))
+	}
+	if len(opts.Tags) > 0 {
+		h.Set(`X-Amz-Tagging`, encodeTags(opts.Tags))
+	}
+	if v := opts.Encryption; v != "" {
+		h.Set(`X-Amz-Server-Side-Encryption`, string(v))
+		if v == EncryptionKMS && opts.KMSKeyId != "" {
+			h.Set(`X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id`, opts.KMSKeyId)
+		}
+	}
+}
+
+// detectContentType returns the content type for the key extension, or
+// sniffed from data if the extension is unknown
+func (opts *UploadOptions) detectContentType(key string, data []byte) string {
+	ext := strings.ToLower(filepath.Ext(key))
+	if v, ok := mimeTypes[ext]; ok {
+		return v
+	}
+	if v := mime.TypeByExtension(ext); v != "" {
+		return v
+	}
+	if len(data) > 0 && !opts.NoSniff {
+		return http.DetectContentType(data)
+	}
+	return "application/octet-stream"
+}
+
 type Writer interface {
 	io.WriteCloser
 
+	// Flush uploads the buffered data as a part right away, e.g. to upload
+	// long-running streams in chunks. Because all parts but the last must be
+	// at least MinPartSize (5 MiB) large, Flush does nothing if less data is
+	// buffered. The part is uploaded in the background; errors are returned
+	// by later calls and Close.
+	Flush() error
+
 	// Abort aborts the current write/upload operation
 	Abort() error
 }
@@ -36,16 +265,26 @@ type writer struct {
 	m        sync.Mutex
 	once     sync.Once
 	wg       sync.WaitGroup
+	ctx      context.Context
+	partCtx  context.Context
+	cancel   context.CancelFunc
 	o        *object
+	opts     UploadOptions
+	header   http.Header
 	buf      *bytes.Buffer
+	partSize int64
 	pc       chan *part
 	partNum  int
 	prepared bool
 	closed   bool
 	aborted  bool
 	uploadId string
+	uploaded map[int]UploadedPart
+	progress *progress
+	limiter  *rateLimiter
 	err      error
-	errAbort error
+	errMu    sync.Mutex
+	errPart  error
 	xml      struct {
 		XMLName string `xml:"CompleteMultipartUpload"`
 		Part    []*part
@@ -56,40 +295,51 @@ type part struct {
 	buf []byte
 
 	// xml
-	PartNumber int
-	ETag       string
+	PartNumber     int
+	ETag           string
+	ChecksumSHA256 string `xml:",omitempty"`
+	ChecksumCRC32C string `xml:",omitempty"`
 }
 
-func newWriter(o *object) *writer {
-	return &writer{
-		o:   o,
-		buf: new(bytes.Buffer),
-		pc:  make(chan *part, nConcurrentUploads),
+func newWriter(ctx context.Context, o *object, opts UploadOptions) *writer {
+	w := &writer{
+		ctx:  ctx,
+		o:    o,
+		opts: opts,
+		buf:  new(bytes.Buffer),
+		pc:   make(chan *part),
 	}
+	w.partCtx, w.cancel = context.WithCancel(ctx)
+	total := opts.Size
+	if total == 0 {
+		total = -1
+	}
+	w.progress = newProgress(opts.Progress, total)
+	w.limiter = newRateLimiter(opts.RateLimit)
+	w.partSize, w.err = opts.partSize()
+	return w
 }
 
 // prepare creates a multipart upload
-func (w *writer) prepare() error {
+func (w *writer) prepare(data []byte) error {
 	if w.prepared {
 		return nil
 	}
-	req, err := http.NewRequest("POST", w.o.url("?uploads"), nil)
+	req, err := w.o.newRequest(w.ctx, "POST", "?uploads", nil)
 	if err != nil {
 		return err
 	}
 
-	// detect mime type
-	ext := filepath.Ext(w.o.key)
-	contentType := "application/octet-stream"
-	if v, ok := mimeTypes[ext]; ok {
-		contentType = v
+	w.opts.setHeaders(req.Header, w.o.key, data)
+	for k, v := range w.header {
+		req.Header[k] = v
+	}
+	if v := w.opts.Checksum; v != "" {
+		req.Header.Set("X-Amz-Checksum-Algorithm", string(v))
 	}
-	req.Header.Set(`Content-Type`, contentType)
 
 	// sign and send
-	w.o.s3.signRequest(req)
-
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := w.o.s3.do(req)
 	if err != nil {
 		return err
 	}
@@ -117,63 +367,150 @@ func (w *writer) Write(p []byte) (n int, err error) {
 	w.m.Lock()
 	defer w.m.Unlock()
 
-	// prepare
-	if !w.prepared {
-		err := w.prepare()
-		if err != nil {
-			return 0, err
-		}
+	if w.err != nil {
+		return 0, w.err
+	}
+	if err := w.partError(); err != nil {
+		return 0, err
 	}
-
-	// schedule worker once
-	w.once.Do(func() {
-		go w.schedule()
-	})
 
 	n, err = w.buf.Write(p)
 	if err != nil {
 		return
 	}
-	if w.buf.Len() > MinPartSize {
-		w.flush()
+	for int64(w.buf.Len()) >= w.partSize && !w.singlePut() {
+		if err = w.flush(int(w.partSize)); err != nil {
+			w.err = err
+			return
+		}
 	}
 	return
 }
 
-func (w *writer) schedule() {
+func (w *writer) Flush() error {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	if w.err != nil {
+		return w.err
+	}
+	if err := w.partError(); err != nil {
+		return err
+	}
+	if w.closed {
+		return errors.New("s3: flush of closed writer")
+	}
+	if w.buf.Len() < MinPartSize {
+		return nil
+	}
+	if err := w.flush(w.buf.Len()); err != nil {
+		w.err = err
+		return err
+	}
+	return nil
+}
+
+// work uploads parts until the part channel is closed. Once a part failed,
+// the remaining parts are skipped.
+func (w *writer) work() {
 	for p := range w.pc {
-		go w.uploadPartRetry(p)
+		if w.partError() == nil {
+			if err := w.uploadPartRetry(p); err != nil {
+				w.setPartError(err)
+			} else {
+				w.progress.add(int64(len(p.buf)))
+			}
+		}
+		w.wg.Done()
 	}
 }
 
-func (w *writer) flush() {
+// partError returns the first part upload error
+func (w *writer) partError() error {
+	w.errMu.Lock()
+	defer w.errMu.Unlock()
+	return w.errPart
+}
+
+// setPartError records the first part upload error and cancels the uploads
+// in flight
+func (w *writer) setPartError(err error) {
+	w.errMu.Lock()
+	defer w.errMu.Unlock()
+	if w.errPart == nil {
+		w.errPart = err
+		w.cancel()
+	}
+}
+
+// flush uploads up to n buffered bytes as the next part
+func (w *writer) flush(n int) error {
 	b := w.buf.Bytes()
 	if len(b) == 0 {
-		return
+		return nil
+	}
+	if w.partNum >= MaxNumParts {
+		return fmt.Errorf("s3: upload exceeds %d parts", MaxNumParts)
+	}
+
+	if n < len(b) {
+		w.buf = bytes.NewBuffer(append([]byte(nil), b[n:]...))
+		b = b[:n]
+	} else {
+		w.buf = new(bytes.Buffer)
 	}
-	w.buf = new(bytes.Buffer)
 	w.partNum++
+	return w.queuePart(w.partNum, b)
+}
+
+// queuePart queues b for upload as part n. The multipart upload is created
+// and the workers are started first if needed.
+func (w *writer) queuePart(n int, b []byte) error {
+	var data []byte
+	if n == 1 {
+		data = b
+	}
+	if err := w.prepare(data); err != nil {
+		return err
+	}
+
+	// start workers once
+	w.once.Do(func() {
+		for i := 0; i < w.opts.concurrency(); i++ {
+			go w.work()
+		}
+	})
+
 	p := &part{
-		PartNumber: w.partNum,
+		PartNumber: n,
 		buf:        b,
 	}
+	switch a := w.opts.Checksum; a {
+	case ChecksumSHA256:
+		p.ChecksumSHA256 = a.checksum(b)
+	case ChecksumCRC32C:
+		p.ChecksumCRC32C = a.checksum(b)
+	}
 	w.xml.Part = append(w.xml.Part, p)
+	if etag, ok := w.isUploaded(p.PartNumber, b); ok {
+		p.ETag = etag
+		w.progress.add(int64(len(b)))
+		return nil
+	}
 	w.wg.Add(1)
 	w.pc <- p
+	return nil
 }
 
-func (w *writer) uploadPartRetry(p *part) {
-	defer w.wg.Done()
+func (w *writer) uploadPartRetry(p *part) error {
 	var err error
 	for i := 0; i < nRetries; i++ {
 		err = w.uploadPart(p)
-		if err == nil {
+		if err == nil || w.partCtx.Err() != nil || errors.Is(err, ErrChecksumMismatch) {
 			break
 		}
 	}
-	if err != nil {
-		w.close(true)
-	}
+	return err
 }
 
 func (w *writer) uploadPart(p *part) error {
@@ -183,16 +520,21 @@ func (w *writer) uploadPart(p *part) error {
 	uv.Set("partNumber", strconv.Itoa(p.PartNumber))
 	uv.Set("uploadId", w.uploadId)
 
-	url := w.o.url(`?` + uv.Encode())
-	req, err := http.NewRequest("PUT", url, buf)
+	req, err := w.o.newRequest(w.partCtx, "PUT", `?`+uv.Encode(), buf)
 	if err != nil {
 		return err
 	}
 	req.ContentLength = int64(buf.Len())
+	w.limiter.limitBody(req)
+	req.Header.Set("Content-MD5", contentMD5(p.buf))
+	if v := p.ChecksumSHA256; v != "" {
+		req.Header.Set(ChecksumSHA256.header(), v)
+	}
+	if v := p.ChecksumCRC32C; v != "" {
+		req.Header.Set(ChecksumCRC32C.header(), v)
+	}
 
-	w.o.s3.signRequest(req)
-
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := w.o.s3.do(req)
 	if err != nil {
 		return err
 	}
@@ -205,9 +547,22 @@ func (w *writer) uploadPart(p *part) error {
 	// trim outer space and quotes from etag
 	p.ETag = strings.Trim(resp.Header.Get("etag"), ` "`)
 
+	if w.opts.VerifyParts && !w.encryptedETag(resp.Header) {
+		if sum := md5.Sum(p.buf); !strings.EqualFold(p.ETag, hex.EncodeToString(sum[:])) {
+			return fmt.Errorf("s3: ETag %q of part %d doesn't match its MD5: %w", p.ETag, p.PartNumber, ErrChecksumMismatch)
+		}
+	}
 	return nil
 }
 
+// encryptedETag reports if the ETag of a part uploaded with the response
+// header h isn't the MD5 of its data because of the encryption
+func (w *writer) encryptedETag(h http.Header) bool {
+	return w.opts.Encryption == EncryptionKMS ||
+		Header(h).ServerSideEncryption() == EncryptionKMS ||
+		h.Get("X-Amz-Server-Side-Encryption-Customer-Algorithm") != ""
+}
+
 func (w *writer) close(abort bool) error {
 	w.m.Lock()
 	defer w.m.Unlock()
@@ -216,43 +571,72 @@ func (w *writer) close(abort bool) error {
 		return nil
 	}
 
-	w.aborted = abort
-	w.flush()
+	err := w.err
+	if err == nil && !abort {
+		if w.singlePut() {
+			err = w.put()
+		} else {
+			err = w.flush(w.buf.Len())
+		}
+	}
 	w.wg.Wait()
 	close(w.pc)
+	w.cancel()
 	w.closed = true
 
-	if abort {
-		return w.abort()
+	if !w.prepared {
+		// nothing to complete or abort
+		return err
 	}
-	return w.complete()
-}
-
-func (w *writer) abort() error {
-	uv := make(url.Values)
-	uv.Set("uploadId", w.uploadId)
-	url := w.o.url("?" + uv.Encode())
-
-	req, err := http.NewRequest("DELETE", url, nil)
-	if err != nil {
+	if err == nil {
+		err = w.partError()
+	}
+	if abort || err != nil {
+		w.aborted = true
+		if aerr := w.abort(); err == nil {
+			err = aerr
+		}
 		return err
 	}
-
-	w.o.s3.signRequest(req)
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
+	if err := w.complete(); err != nil {
+		if err == ErrPreconditionFailed {
+			// the parts are not needed anymore
+			w.abort()
+		}
 		return err
 	}
-	defer resp.Body.Close()
+	w.progress.done()
+	return nil
+}
 
-	if c := resp.StatusCode; c != 204 {
-		return newS3Error(resp, "could not abort upload: %d", c)
-	}
+// singlePut reports if the buffered data can be uploaded with a single PUT
+// request, because no multipart upload was started and the buffer is not
+// larger than the threshold
+func (w *writer) singlePut() bool {
+	return !w.prepared && w.uploadId == "" &&
+		int64(w.buf.Len()) <= w.opts.singlePutThreshold()
+}
 
+// put uploads the buffered data with a single PUT request
+func (w *writer) put() error {
+	b := w.buf.Bytes()
+	if err := w.o.put(w.ctx, b, w.opts); err != nil {
+		return err
+	}
+	w.progress.add(int64(len(b)))
+	w.progress.done()
 	return nil
 }
 
+// abort aborts the multipart upload. It does not use the caller's context
+// directly: a cancelled context is the main reason to abort, and the
+// upload would otherwise be left behind.
+func (w *writer) abort() error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(w.ctx), abortTimeout)
+	defer cancel()
+	return w.o.abortUpload(ctx, w.uploadId)
+}
+
 func (w *writer) complete() error {
 
 	b, err := xml.Marshal(w.xml)
@@ -263,22 +647,20 @@ func (w *writer) complete() error {
 	uv := make(url.Values)
 	uv.Set("uploadId", w.uploadId)
 
-	url := w.o.url(`?` + uv.Encode())
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(b))
+	req, err := w.o.newRequest(w.ctx, "POST", `?`+uv.Encode(), bytes.NewBuffer(b))
 	if err != nil {
 		return err
 	}
+	w.opts.Preconditions.setHeaders(req.Header)
 
-	w.o.s3.signRequest(req)
-
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := w.o.s3.do(req)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
 	if c := resp.StatusCode; c != 200 {
-		return newS3Error(resp, "could not complete upload: %d", c)
+		return preconditionError(newS3Error(resp, "could not complete upload: %d", c))
 	}
 	return nil
 }
@@ -290,25 +672,3 @@ func (w *writer) Close() error {
 func (w *writer) Abort() error {
 	return w.close(true)
 }
-
-type s3err struct {
-	code    int
-	text    string
-	xmlBody string
-}
-
-func newS3Error(resp *http.Response, strFmt string, args ...interface{}) *s3err {
-	var b bytes.Buffer
-	if resp != nil {
-		b.ReadFrom(resp.Body)
-	}
-	return &s3err{
-		code:    resp.StatusCode,
-		text:    fmt.Sprintf(strFmt, args...),
-		xmlBody: b.String(),
-	}
-}
-
-func (e *s3err) Error() string {
-	return e.text
-}
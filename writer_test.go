@@ -0,0 +1,610 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWriterWithOptions(t *testing.T) {
+	s3, _ := newFakeS3(t)
+	o := s3.Object("data.txt")
+
+	w := o.WriterWithOptions(UploadOptions{
+		ContentType:        "text/csv",
+		CacheControl:       "max-age=60",
+		ContentDisposition: `attachment; filename="data.csv"`,
+	})
+	if _, err := io.Copy(w, strings.NewReader("a,b,c\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	h, err := o.Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := h.ContentType(); x != "text/csv" {
+		t.Fatal(x)
+	}
+	if x := h["Cache-Control"]; len(x) != 1 || x[0] != "max-age=60" {
+		t.Fatal(x)
+	}
+	if x := h["Content-Disposition"]; len(x) != 1 || x[0] != `attachment; filename="data.csv"` {
+		t.Fatal(x)
+	}
+
+	// detected from extension
+	w = o.Writer()
+	if _, err := io.Copy(w, strings.NewReader("hello")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	h, err = o.Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := h.ContentType(); x != "text/plain" {
+		t.Fatal(x)
+	}
+}
+
+func TestEncryption(t *testing.T) {
+	s3, f := newFakeS3(t)
+	o := s3.Object("secret.txt")
+
+	for _, v := range []struct {
+		enc   Encryption
+		keyId string
+	}{
+		{EncryptionAES256, ""},
+		{EncryptionKMS, ""},
+		{EncryptionKMS, "arn:aws:kms:us-east-1:123456789012:key/key-id"},
+	} {
+		// multipart
+		w := o.WriterWithOptions(UploadOptions{Encryption: v.enc, KMSKeyId: v.keyId})
+		if _, err := io.Copy(w, strings.NewReader("secret")); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		h, err := o.Head()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if x := h.ServerSideEncryption(); x != v.enc {
+			t.Fatal(x)
+		}
+		if x := http.Header(h).Get("X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id"); x != v.keyId {
+			t.Fatal(x)
+		}
+
+		// single request
+		if err := o.Put(strings.NewReader("secret"), 6, WithEncryption(v.enc, v.keyId)); err != nil {
+			t.Fatal(err)
+		}
+		hh := f.lastHeader()
+		if x := hh.Get("X-Amz-Server-Side-Encryption"); x != string(v.enc) {
+			t.Fatal(x)
+		}
+		if x := hh.Get("X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id"); x != v.keyId {
+			t.Fatal(x)
+		}
+	}
+
+	// key id without KMS is ignored
+	if err := o.Put(strings.NewReader("secret"), 6, WithEncryption(EncryptionAES256, "key")); err != nil {
+		t.Fatal(err)
+	}
+	if x := f.lastHeader().Get("X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id"); x != "" {
+		t.Fatal(x)
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	s3, _ := newFakeS3(t)
+	o := s3.Object("meta.txt")
+
+	meta := map[string]string{
+		"Owner":            "alice",
+		"content-reviewer": "Bob",
+		"X-Trace-ID":       "1234",
+	}
+
+	w := o.WriterWithOptions(UploadOptions{Metadata: meta})
+	if _, err := io.Copy(w, strings.NewReader("meta")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	check := func() {
+		h, err := o.Head()
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := h.Metadata()
+		if x := len(m); x != 3 {
+			t.Fatal(m)
+		}
+		if x := m["owner"]; x != "alice" {
+			t.Fatal(m)
+		}
+		if x := m["content-reviewer"]; x != "Bob" {
+			t.Fatal(m)
+		}
+		if x := m["x-trace-id"]; x != "1234" {
+			t.Fatal(m)
+		}
+	}
+	check()
+
+	if err := o.Delete(); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.Put(strings.NewReader("meta"), 4, WithMetadata(meta)); err != nil {
+		t.Fatal(err)
+	}
+	check()
+}
+
+func TestStorageClass(t *testing.T) {
+	s3, f := newFakeS3(t)
+	o := s3.Object("key")
+
+	// default
+	if err := o.Put(strings.NewReader("x"), 1); err != nil {
+		t.Fatal(err)
+	}
+	h, err := o.Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := h.StorageClass(); x != StorageStandard {
+		t.Fatal(x)
+	}
+
+	for class, value := range map[StorageClass]string{
+		StorageStandard:           "STANDARD",
+		StorageStandardIA:         "STANDARD_IA",
+		StorageOneZoneIA:          "ONEZONE_IA",
+		StorageIntelligentTiering: "INTELLIGENT_TIERING",
+		StorageReducedRedundancy:  "REDUCED_REDUNDANCY",
+		StorageGlacier:            "GLACIER",
+		StorageDeepArchive:        "DEEP_ARCHIVE",
+	} {
+		if err := o.Put(strings.NewReader("x"), 1, WithStorageClass(class)); err != nil {
+			t.Fatal(err)
+		}
+		if x := f.lastHeader().Get("X-Amz-Storage-Class"); x != value {
+			t.Fatal(x)
+		}
+
+		w := o.WriterWithOptions(UploadOptions{StorageClass: class})
+		if _, err := io.Copy(w, strings.NewReader("x")); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		h, err := o.Head()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if x := h.StorageClass(); x != class {
+			t.Fatal(x)
+		}
+	}
+}
+
+func TestPartSize(t *testing.T) {
+	for _, v := range []struct {
+		partSize, size, expected int64
+	}{
+		{0, 0, MinPartSize},
+		{0, 10 * MinPartSize, MinPartSize},
+		{0, MaxNumParts * MinPartSize, MinPartSize},
+		{0, MaxNumParts*MinPartSize + 1, MinPartSize + 1},
+		{MinPartSize - 1, 0, 0},
+		{MaxPartSize + 1, 0, 0},
+		{2 * MinPartSize, 0, 2 * MinPartSize},
+		{MinPartSize, MaxNumParts * MinPartSize, MinPartSize},
+		{MinPartSize, MaxNumParts*MinPartSize + 1, 0},
+	} {
+		opts := UploadOptions{PartSize: v.partSize, Size: v.size}
+		size, err := opts.partSize()
+		if v.expected == 0 {
+			if err == nil {
+				t.Fatal(v, size)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(v, err)
+		}
+		if size != v.expected {
+			t.Fatal(v, size)
+		}
+	}
+
+	for _, v := range []struct {
+		size, partSize, n int64
+	}{
+		{0, MinPartSize, 0},
+		{1, MinPartSize, 1},
+		{MinPartSize, MinPartSize, 1},
+		{MinPartSize + 1, MinPartSize, 2},
+		{11 * MinPartSize, 2 * MinPartSize, 6},
+	} {
+		if x := partCount(v.size, v.partSize); x != v.n {
+			t.Fatal(v, x)
+		}
+	}
+}
+
+func TestWriterPartSize(t *testing.T) {
+	s3, f := newFakeS3(t)
+	o := s3.Object("key")
+
+	// invalid
+	w := o.WriterWithOptions(UploadOptions{PartSize: 1024})
+	if _, err := w.Write([]byte("x")); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := w.Close(); err == nil {
+		t.Fatal("expected error")
+	}
+	if x := f.count("POST"); x != 0 {
+		t.Fatal(x)
+	}
+
+	// 2 full parts and a smaller last part
+	data := bytes.Repeat([]byte("0123456789"), (2*MinPartSize+MinPartSize/2)/10)
+	w = o.WriterWithOptions(UploadOptions{PartSize: MinPartSize})
+	if _, err := io.Copy(w, bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if x := f.count("PUT /key?partNumber="); x != 3 {
+		t.Fatal(x)
+	}
+	if x := f.get("/key").body; !bytes.Equal(x, data) {
+		t.Fatal(len(x))
+	}
+}
+
+func TestWriterConcurrency(t *testing.T) {
+	f := newFakeServer()
+	var mu sync.Mutex
+	active, max := 0, 0
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n, _ := strconv.Atoi(r.URL.Query().Get("partNumber"))
+		if n > 0 {
+			mu.Lock()
+			active++
+			if active > max {
+				max = active
+			}
+			mu.Unlock()
+
+			// earlier parts finish last
+			time.Sleep(time.Duration(6-n) * 20 * time.Millisecond)
+
+			mu.Lock()
+			active--
+			mu.Unlock()
+		}
+		f.ServeHTTP(w, r)
+	}))
+
+	data := bytes.Repeat([]byte("0123456789"), 5*MinPartSize/10)
+	w := s3.Object("key").WriterWithOptions(UploadOptions{Concurrency: 3})
+	if _, err := io.Copy(w, bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	// the fake server rejects parts out of order
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if x := f.get("/key").body; !bytes.Equal(x, data) {
+		t.Fatal(len(x))
+	}
+	if max < 2 || max > 3 {
+		t.Fatal(max)
+	}
+}
+
+func TestWriterPartError(t *testing.T) {
+	f := newFakeServer()
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("partNumber") == "2" {
+			f.error(w, 400, "InvalidArgument")
+			return
+		}
+		f.ServeHTTP(w, r)
+	}))
+
+	data := bytes.Repeat([]byte("0123456789"), 4*MinPartSize/10)
+	w := s3.Object("key").WriterWithOptions(UploadOptions{Concurrency: 2})
+	_, err := io.Copy(w, bytes.NewReader(data))
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	if e, ok := err.(*S3Error); !ok || e.Code != "InvalidArgument" {
+		t.Fatal(err)
+	}
+	if x := f.count("DELETE /key?uploadId="); x != 1 {
+		t.Fatal(x)
+	}
+	if f.get("/key") != nil {
+		t.Fatal("unexpected object")
+	}
+}
+
+func TestWriterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	f := newFakeServer()
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("partNumber") == "2" {
+			cancel()
+		}
+		f.ServeHTTP(w, r)
+	}))
+
+	data := bytes.Repeat([]byte("0123456789"), 4*MinPartSize/10)
+	w := s3.Object("key").WriterContext(ctx)
+	_, err := io.Copy(w, bytes.NewReader(data))
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Fatal(err)
+	}
+	if x := f.count("DELETE /key?uploadId="); x != 1 {
+		t.Fatal(x)
+	}
+}
+
+func TestWriterSinglePut(t *testing.T) {
+	s3, f := newFakeS3(t)
+	o := s3.Object("key.txt")
+
+	for _, v := range []struct {
+		size      int
+		threshold int64
+		multipart bool
+	}{
+		{1024, 0, false},
+		{0, 0, false},
+		{MinPartSize, 0, false},
+		{MinPartSize + 1, 0, true},
+		{1024, -1, true},
+		{2048, 1024, true},
+		{MinPartSize + 1, 2 * MinPartSize, false},
+	} {
+		before := f.count("POST /key.txt?uploads")
+		data := bytes.Repeat([]byte("x"), v.size)
+		w := o.WriterWithOptions(UploadOptions{SinglePutThreshold: v.threshold, Metadata: map[string]string{"a": "b"}})
+		for i := 0; i < len(data); i += 100 {
+			end := i + 100
+			if end > len(data) {
+				end = len(data)
+			}
+			if _, err := w.Write(data[i:end]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(v, err)
+		}
+		if x := f.count("POST /key.txt?uploads") - before; x != map[bool]int{false: 0, true: 1}[v.multipart] {
+			t.Fatal(v, x)
+		}
+		obj := f.get("/key.txt")
+		if !bytes.Equal(obj.body, data) {
+			t.Fatal(v, len(obj.body))
+		}
+		if obj.header.Get("Content-Type") != "text/plain" || obj.header.Get("X-Amz-Meta-A") != "b" {
+			t.Fatal(obj.header)
+		}
+	}
+
+	// aborting before anything was uploaded
+	before := len(f.requests)
+	w := o.Writer()
+	w.Write([]byte("data"))
+	if err := w.Abort(); err != nil {
+		t.Fatal(err)
+	}
+	if x := len(f.requests); x != before {
+		t.Fatal(x)
+	}
+}
+
+func TestDetectContentType(t *testing.T) {
+	s3, f := newFakeS3(t)
+	png := "\x89PNG\r\n\x1a\nrest"
+	for _, v := range []struct {
+		key, data string
+		opts      UploadOptions
+		expected  string
+	}{
+		{"site.css", "body {}", UploadOptions{}, "text/css"},
+		{"SITE.CSS", "body {}", UploadOptions{}, "text/css"},
+		{"image.unknownext", png, UploadOptions{}, "image/png"},
+		{"image", png, UploadOptions{}, "image/png"},
+		{"image", png, UploadOptions{NoSniff: true}, "application/octet-stream"},
+		{"image", png, UploadOptions{ContentType: "text/plain"}, "text/plain"},
+		{"multipart", png, UploadOptions{SinglePutThreshold: -1}, "image/png"},
+	} {
+		w := s3.Object(v.key).WriterWithOptions(v.opts)
+		w.Write([]byte(v.data))
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if x := f.get("/" + v.key).header.Get("Content-Type"); x != v.expected {
+			t.Fatal(v.key, x)
+		}
+	}
+}
+
+func TestWriterVerifyParts(t *testing.T) {
+	f := newFakeServer()
+	s3 := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/key" && r.URL.Query().Get("partNumber") == "2" {
+			f.ServeHTTP(httptest.NewRecorder(), r)
+			w.Header().Set("ETag", `"00000000000000000000000000000000"`)
+			return
+		}
+		f.ServeHTTP(w, r)
+	}))
+
+	data := bytes.Repeat([]byte("0123456789"), 4*MinPartSize/10)
+	w := s3.Object("key").WriterWithOptions(UploadOptions{Concurrency: 2, VerifyParts: true})
+	_, err := io.Copy(w, bytes.NewReader(data))
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	if !errors.Is(err, ErrChecksumMismatch) || !strings.Contains(err.Error(), "part 2") {
+		t.Fatal(err)
+	}
+	if x := f.count("PUT /key?partNumber=2"); x != 1 {
+		t.Fatal(x)
+	}
+	if x := f.count("DELETE /key?uploadId="); x != 1 {
+		t.Fatal(x)
+	}
+	if f.get("/key") != nil {
+		t.Fatal("unexpected object")
+	}
+
+	// matching ETags
+	w = s3.Object("other").WriterWithOptions(UploadOptions{VerifyParts: true})
+	if _, err := w.Write(data[:MinPartSize+1]); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// ETags of KMS encrypted parts aren't MD5 sums
+	kms := newTestS3(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch q := r.URL.Query(); {
+		case q.Get("partNumber") != "":
+			f.ServeHTTP(httptest.NewRecorder(), r)
+			if r.URL.Path == "/kms" {
+				w.Header().Set("X-Amz-Server-Side-Encryption", "aws:kms")
+			}
+			w.Header().Set("ETag", `"00000000000000000000000000000000"`)
+		case r.Method == "POST" && q.Get("uploadId") != "":
+			// S3 accepts the ETags it returned
+		default:
+			f.ServeHTTP(w, r)
+		}
+	}))
+	for key, opts := range map[string]UploadOptions{
+		"kms":  {VerifyParts: true},
+		"kms2": {VerifyParts: true, Encryption: EncryptionKMS},
+	} {
+		w = kms.Object(key).WriterWithOptions(opts)
+		if _, err := w.Write(data[:MinPartSize+1]); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestWriterFlush(t *testing.T) {
+	s3, f := newFakeS3(t)
+	data := bytes.Repeat([]byte("0123456789"), MinPartSize/10)
+
+	w := s3.Object("key").WriterWithOptions(UploadOptions{PartSize: 2 * MinPartSize, Concurrency: 1})
+	parts := func() int { return f.count("PUT /key?partNumber=") }
+
+	// too little data for a part
+	w.Write(data[:MinPartSize/2])
+	if err := w.Flush(); err != nil || parts() != 0 {
+		t.Fatal(parts(), err)
+	}
+	w.Write(data[:MinPartSize/2+1])
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	w.Write(data[:10])
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(); err == nil {
+		t.Fatal("expected error")
+	}
+	if x := parts(); x != 2 {
+		t.Fatal(x)
+	}
+	if x := f.get("/key").parts; len(x) != 2 || x[0] != MinPartSize+1 || x[1] != 10 {
+		t.Fatal(x)
+	}
+}
+
+func TestContentEncoding(t *testing.T) {
+	s3, _ := newFakeS3(t)
+	data := []byte("already compressed")
+
+	// single PUT
+	o := s3.Object("single")
+	if err := o.Put(bytes.NewReader(data), int64(len(data)), WithContentEncoding("br"), WithContentType("text/plain")); err != nil {
+		t.Fatal(err)
+	}
+	// multipart upload
+	w := s3.Object("multipart").WriterWithOptions(UploadOptions{ContentEncoding: "zstd", SinglePutThreshold: -1})
+	w.Write(data)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for key, enc := range map[string]string{"single": "br", "multipart": "zstd"} {
+		h, err := s3.Object(key).Head()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if x := http.Header(h).Get("Content-Encoding"); x != enc {
+			t.Fatal(key, x)
+		}
+	}
+	if h, _ := s3.Object("single").Head(); h.ContentType() != "text/plain" {
+		t.Fatal(h.ContentType())
+	}
+
+	// gzip is applied last
+	w = s3.Object("gzip").WriterWithOptions(UploadOptions{ContentEncoding: "br", Gzip: true})
+	w.Write(data)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	h, err := s3.Object("gzip").Head()
+	if x := http.Header(h).Get("Content-Encoding"); err != nil || x != "br, gzip" {
+		t.Fatal(x, err)
+	}
+}
@@ -0,0 +1,186 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// UploaderAt uploads data that is written at arbitrary offsets, in any order.
+// Each byte must be written exactly once. A part is uploaded as soon as it
+// is complete; Close uploads the last part and completes the upload.
+type UploaderAt interface {
+	io.WriterAt
+
+	// Close completes the upload. It fails if data is missing.
+	Close() error
+
+	// Abort aborts the upload
+	Abort() error
+}
+
+type uploaderAt struct {
+	m      sync.Mutex
+	w      *writer
+	parts  map[int64]*partBuffer
+	end    int64
+	closed bool
+}
+
+// partBuffer holds the data of a part until it is complete
+type partBuffer struct {
+	buf     []byte
+	n       int64
+	written [][2]int64
+	queued  bool
+}
+
+// add records that the range [start, end) of the part was written. The
+// written ranges are kept sorted and disjoint. add fails if the range
+// overlaps data that was already written, since the part could otherwise be
+// considered complete with a gap.
+func (pb *partBuffer) add(start, end int64) error {
+	i := sort.Search(len(pb.written), func(i int) bool { return pb.written[i][1] > start })
+	if i < len(pb.written) && pb.written[i][0] < end {
+		return errors.New("s3: overlapping write")
+	}
+	pb.written = append(pb.written, [2]int64{})
+	copy(pb.written[i+1:], pb.written[i:])
+	pb.written[i] = [2]int64{start, end}
+	pb.n += end - start
+	return nil
+}
+
+func (o *object) UploaderAt(opts UploadOptions) UploaderAt {
+	w := newWriter(context.Background(), o, opts)
+	if opts.Gzip {
+		w.err = errors.New("s3: UploaderAt doesn't support Gzip")
+	}
+	return &uploaderAt{w: w, parts: make(map[int64]*partBuffer)}
+}
+
+func (u *uploaderAt) WriteAt(p []byte, off int64) (int, error) {
+	u.m.Lock()
+	defer u.m.Unlock()
+
+	if u.closed {
+		return 0, errors.New("s3: write to closed uploader")
+	}
+	if u.w.err != nil {
+		return 0, u.w.err
+	}
+	if err := u.w.partError(); err != nil {
+		return 0, err
+	}
+	if off < 0 {
+		return 0, errors.New("s3: negative offset")
+	}
+
+	ps := u.w.partSize
+	n := 0
+	for n < len(p) {
+		i := off / ps
+		if i >= MaxNumParts {
+			return n, fmt.Errorf("s3: upload exceeds %d parts", MaxNumParts)
+		}
+		pb := u.parts[i]
+		if pb == nil {
+			pb = &partBuffer{buf: make([]byte, ps)}
+			u.parts[i] = pb
+		}
+		if pb.queued {
+			return n, fmt.Errorf("s3: part %d was already uploaded", i+1)
+		}
+
+		start := off - i*ps
+		k := len(p) - n
+		if int64(k) > ps-start {
+			k = int(ps - start)
+		}
+		if err := pb.add(start, start+int64(k)); err != nil {
+			return n, err
+		}
+		copy(pb.buf[start:], p[n:n+k])
+		n += k
+		off += int64(k)
+		if off > u.end {
+			u.end = off
+		}
+
+		if pb.n == ps {
+			if err := u.queue(i, pb.buf); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// queue queues the part with index i for upload
+func (u *uploaderAt) queue(i int64, b []byte) error {
+	pb := u.parts[i]
+	pb.queued = true
+	pb.buf = nil
+	if err := u.w.queuePart(int(i)+1, b); err != nil {
+		u.w.err = err
+		return err
+	}
+	return nil
+}
+
+func (u *uploaderAt) Close() error {
+	u.m.Lock()
+	defer u.m.Unlock()
+
+	if u.closed {
+		return nil
+	}
+	u.closed = true
+	if u.w.err != nil {
+		return u.w.close(true)
+	}
+	if u.end == 0 {
+		return u.w.close(false)
+	}
+
+	// all parts but the last must have been uploaded
+	ps := u.w.partSize
+	last := (u.end - 1) / ps
+	for i := int64(0); i <= last; i++ {
+		pb := u.parts[i]
+		size := ps
+		if i == last {
+			size = u.end - i*ps
+		}
+		if pb == nil || !pb.queued && pb.n != size {
+			u.w.close(true)
+			return fmt.Errorf("s3: data of part %d is incomplete", i+1)
+		}
+	}
+
+	if !u.w.prepared && u.end <= u.w.opts.singlePutThreshold() {
+		// small objects are uploaded with a single request
+		u.w.buf.Write(u.parts[0].buf[:u.end])
+		return u.w.close(false)
+	}
+	if pb := u.parts[last]; !pb.queued {
+		if err := u.queue(last, pb.buf[:u.end-last*ps]); err != nil {
+			u.w.close(true)
+			return err
+		}
+	}
+	sort.Slice(u.w.xml.Part, func(i, j int) bool {
+		return u.w.xml.Part[i].PartNumber < u.w.xml.Part[j].PartNumber
+	})
+	return u.w.close(false)
+}
+
+func (u *uploaderAt) Abort() error {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.closed = true
+	return u.w.close(true)
+}
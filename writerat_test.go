@@ -0,0 +1,101 @@
+package s3
+
+import (
+	"bytes"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestUploaderAt(t *testing.T) {
+	s3, f := newFakeS3(t)
+
+	data := make([]byte, 2*MinPartSize+100)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	// write 1 MiB chunks in reverse order, the last one spanning two parts
+	u := s3.Object("key").UploaderAt(UploadOptions{})
+	const chunk = 1 << 20
+	for off := len(data) / chunk * chunk; off >= 0; off -= chunk {
+		end := off + chunk
+		if end > len(data) {
+			end = len(data)
+		}
+		if n, err := u.WriteAt(data[off:end], int64(off)); err != nil || n != end-off {
+			t.Fatal(n, err)
+		}
+	}
+	if err := u.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if o := f.get("/key"); o == nil || !bytes.Equal(o.body, data) {
+		t.Fatal("object differs")
+	}
+	if x := f.count("PUT /key?partNumber="); x != 3 {
+		t.Fatal(x)
+	}
+
+	// a write across a part boundary
+	u = s3.Object("key2").UploaderAt(UploadOptions{})
+	u.WriteAt(data[MinPartSize-10:], MinPartSize-10)
+	u.WriteAt(data[:MinPartSize-10], 0)
+	if err := u.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if o := f.get("/key2"); o == nil || !bytes.Equal(o.body, data) {
+		t.Fatal("object differs")
+	}
+
+	// small objects are uploaded with a single request
+	u = s3.Object("small").UploaderAt(UploadOptions{})
+	u.WriteAt([]byte("world"), 6)
+	u.WriteAt([]byte("hello "), 0)
+	if err := u.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if o := f.get("/small"); o == nil || string(o.body) != "hello world" || f.count("POST /small") != 0 {
+		t.Fatal(f.requests)
+	}
+
+	// missing data
+	u = s3.Object("gap").UploaderAt(UploadOptions{})
+	u.WriteAt(data[:MinPartSize], 0)
+	u.WriteAt(data[MinPartSize+10:], MinPartSize+10)
+	if err := u.Close(); err == nil || !strings.Contains(err.Error(), "part 2") {
+		t.Fatal(err)
+	}
+	if f.get("/gap") != nil || len(f.uploads) != 0 {
+		t.Fatal("upload not aborted")
+	}
+
+	// a rewritten range doesn't cover a gap
+	u = s3.Object("overlap").UploaderAt(UploadOptions{})
+	u.WriteAt(data[:10], 0)
+	if _, err := u.WriteAt(data[5:15], 5); err == nil {
+		t.Fatal("expected error")
+	}
+	u.WriteAt(data[20:MinPartSize+5], 20)
+	if err := u.Close(); err == nil || !strings.Contains(err.Error(), "part 1") {
+		t.Fatal(err)
+	}
+	if f.get("/overlap") != nil {
+		t.Fatal("unexpected object")
+	}
+
+	// data written at offsets can't be compressed
+	u = s3.Object("gzip").UploaderAt(UploadOptions{Gzip: true})
+	if _, err := u.WriteAt([]byte("hello"), 0); err == nil || !strings.Contains(err.Error(), "Gzip") {
+		t.Fatal(err)
+	}
+	if err := u.Close(); err == nil || f.get("/gzip") != nil {
+		t.Fatal(err)
+	}
+
+	// rewriting an uploaded part
+	u = s3.Object("twice").UploaderAt(UploadOptions{})
+	u.WriteAt(data[:MinPartSize], 0)
+	if _, err := u.WriteAt(data[:1], 0); err == nil {
+		t.Fatal("expected error")
+	}
+	u.Abort()
+}
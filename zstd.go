@@ -0,0 +1,970 @@
+package s3
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/bits"
+)
+
+// zstd decoding as specified by RFC 8878. Frames that require a dictionary
+// are not supported.
+//
+// https://www.rfc-editor.org/rfc/rfc8878
+
+var errZstd = errors.New("s3: invalid zstd data")
+
+const (
+	zstdMagic = 0xFD2FB528
+
+	// zstdMaxWindow is the largest window that is accepted, like the
+	// default limit of the reference decoder
+	zstdMaxWindow = 1 << 27
+
+	zstdMaxBlock = 128 << 10
+)
+
+// zstdReader decompresses a stream of zstd frames
+type zstdReader struct {
+	r   *bufio.Reader
+	err error
+
+	// hist holds the window of the current frame, followed by the decoded
+	// data from off on that wasn't read yet
+	hist []byte
+	off  int
+
+	// the state of the current frame
+	inFrame    bool
+	window     int
+	checksum   bool
+	hash       xxhash64
+	rep        [3]int
+	huff       *zstdHuffman
+	ll, of, ml *fseTable
+	block      []byte
+	lits       []byte
+}
+
+func newZstdReader(r io.Reader) *zstdReader {
+	return &zstdReader{r: bufio.NewReader(r)}
+}
+
+func (z *zstdReader) Read(p []byte) (int, error) {
+	for z.off == len(z.hist) {
+		if z.err != nil {
+			return 0, z.err
+		}
+		if z.inFrame {
+			z.err = z.nextBlock()
+		} else {
+			z.err = z.nextFrame()
+		}
+	}
+	n := copy(p, z.hist[z.off:])
+	z.off += n
+	return n, nil
+}
+
+func (z *zstdReader) Close() error {
+	return nil
+}
+
+// nextFrame reads the header of the next frame and skips skippable frames.
+// It returns io.EOF at the end of the stream.
+func (z *zstdReader) nextFrame() error {
+	var b [8]byte
+	if _, err := io.ReadFull(z.r, b[:4]); err != nil {
+		// io.EOF only if the stream ends between frames
+		return err
+	}
+	magic := binary.LittleEndian.Uint32(b[:])
+	if magic&0xFFFFFFF0 == 0x184D2A50 {
+		if _, err := io.ReadFull(z.r, b[:4]); err != nil {
+			return noEOF(err)
+		}
+		_, err := z.r.Discard(int(binary.LittleEndian.Uint32(b[:])))
+		return noEOF(err)
+	}
+	if magic != zstdMagic {
+		return errZstd
+	}
+
+	fhd, err := z.r.ReadByte()
+	if err != nil {
+		return noEOF(err)
+	}
+	if fhd&0x08 != 0 {
+		return errZstd
+	}
+	single := fhd&0x20 != 0
+	var window uint64
+	if !single {
+		wd, err := z.r.ReadByte()
+		if err != nil {
+			return noEOF(err)
+		}
+		base := uint64(1) << (10 + wd>>3)
+		window = base + base/8*uint64(wd&7)
+	}
+	if n := [4]int{0, 1, 2, 4}[fhd&3]; n > 0 {
+		if _, err := io.ReadFull(z.r, b[:n]); err != nil {
+			return noEOF(err)
+		}
+		for _, c := range b[:n] {
+			if c != 0 {
+				return errors.New("s3: zstd dictionaries are not supported")
+			}
+		}
+	}
+	n := [4]int{0, 2, 4, 8}[fhd>>6]
+	if n == 0 && single {
+		n = 1
+	}
+	if n > 0 {
+		b = [8]byte{}
+		if _, err := io.ReadFull(z.r, b[:n]); err != nil {
+			return noEOF(err)
+		}
+		size := binary.LittleEndian.Uint64(b[:])
+		if n == 2 {
+			size += 256
+		}
+		if single {
+			window = size
+		}
+	}
+	if window > zstdMaxWindow {
+		return errors.New("s3: zstd window is too large")
+	}
+
+	z.inFrame = true
+	z.window = int(window)
+	z.checksum = fhd&0x04 != 0
+	z.hash.reset()
+	z.rep = [3]int{1, 4, 8}
+	z.huff = nil
+	z.ll, z.of, z.ml = nil, nil, nil
+	z.hist, z.off = z.hist[:0], 0
+	return nil
+}
+
+// nextBlock decodes the next block of the current frame
+func (z *zstdReader) nextBlock() error {
+	// keep the window only, but not on every block
+	if n := len(z.hist) - z.window; n > z.window+zstdMaxBlock {
+		z.hist = z.hist[:copy(z.hist, z.hist[n:])]
+		z.off -= n
+	}
+
+	var b [4]byte
+	if _, err := io.ReadFull(z.r, b[:3]); err != nil {
+		return noEOF(err)
+	}
+	h := binary.LittleEndian.Uint32(b[:])
+	last := h&1 != 0
+	size := int(h >> 3)
+	maxBlock := zstdMaxBlock
+	if z.window < maxBlock {
+		maxBlock = z.window
+	}
+	if size > maxBlock {
+		return errZstd
+	}
+
+	start := len(z.hist)
+	switch h >> 1 & 3 {
+	case 0: // raw
+		z.hist = grow(z.hist, size)
+		if _, err := io.ReadFull(z.r, z.hist[start:]); err != nil {
+			return noEOF(err)
+		}
+	case 1: // RLE
+		c, err := z.r.ReadByte()
+		if err != nil {
+			return noEOF(err)
+		}
+		z.hist = grow(z.hist, size)
+		for i := start; i < len(z.hist); i++ {
+			z.hist[i] = c
+		}
+	case 2: // compressed
+		if cap(z.block) < size {
+			z.block = make([]byte, size)
+		}
+		z.block = z.block[:size]
+		if _, err := io.ReadFull(z.r, z.block); err != nil {
+			return noEOF(err)
+		}
+		if err := z.decompress(z.block, maxBlock); err != nil {
+			return err
+		}
+	default:
+		return errZstd
+	}
+
+	if z.checksum {
+		z.hash.write(z.hist[start:])
+	}
+	if last {
+		z.inFrame = false
+		if z.checksum {
+			if _, err := io.ReadFull(z.r, b[:]); err != nil {
+				return noEOF(err)
+			}
+			if binary.LittleEndian.Uint32(b[:]) != uint32(z.hash.sum()) {
+				return ErrChecksumMismatch
+			}
+		}
+	}
+	return nil
+}
+
+// decompress decodes the compressed block b and appends the data to hist
+func (z *zstdReader) decompress(b []byte, maxBlock int) error {
+	n, err := z.literals(b, maxBlock)
+	if err != nil {
+		return err
+	}
+	b = b[n:]
+
+	if len(b) == 0 {
+		return errZstd
+	}
+	nseq := int(b[0])
+	switch {
+	case nseq < 128:
+		b = b[1:]
+	case nseq < 255:
+		if len(b) < 2 {
+			return errZstd
+		}
+		nseq = (nseq-128)<<8 + int(b[1])
+		b = b[2:]
+	default:
+		if len(b) < 3 {
+			return errZstd
+		}
+		nseq = int(b[1]) + int(b[2])<<8 + 0x7F00
+		b = b[3:]
+	}
+	if nseq == 0 {
+		if len(b) != 0 {
+			return errZstd
+		}
+		z.hist = append(z.hist, z.lits...)
+		return nil
+	}
+
+	if len(b) == 0 {
+		return errZstd
+	}
+	modes := b[0]
+	if modes&3 != 0 {
+		return errZstd
+	}
+	b = b[1:]
+	if b, err = seqTable(b, modes>>6, &z.ll, zstdLLDefault, 35, 9); err != nil {
+		return err
+	}
+	if b, err = seqTable(b, modes>>4&3, &z.of, zstdOFDefault, 31, 8); err != nil {
+		return err
+	}
+	if b, err = seqTable(b, modes>>2&3, &z.ml, zstdMLDefault, 52, 9); err != nil {
+		return err
+	}
+	return z.sequences(b, nseq, len(z.hist)+maxBlock)
+}
+
+// literals decodes the literals section at the start of b into lits and
+// returns its size
+func (z *zstdReader) literals(b []byte, maxBlock int) (int, error) {
+	if len(b) == 0 {
+		return 0, errZstd
+	}
+	typ, format := b[0]&3, b[0]>>2&3
+
+	if typ < 2 {
+		// raw or RLE
+		var size, n int
+		switch format {
+		case 0, 2:
+			size, n = int(b[0]>>3), 1
+		case 1:
+			if len(b) < 2 {
+				return 0, errZstd
+			}
+			size, n = int(b[0]>>4)+int(b[1])<<4, 2
+		case 3:
+			if len(b) < 3 {
+				return 0, errZstd
+			}
+			size, n = int(b[0]>>4)+int(b[1])<<4+int(b[2])<<12, 3
+		}
+		if size > maxBlock {
+			return 0, errZstd
+		}
+		z.lits = grow(z.lits[:0], size)
+		if typ == 0 {
+			if len(b) < n+size {
+				return 0, errZstd
+			}
+			copy(z.lits, b[n:])
+			return n + size, nil
+		}
+		if len(b) < n+1 {
+			return 0, errZstd
+		}
+		for i := range z.lits {
+			z.lits[i] = b[n]
+		}
+		return n + 1, nil
+	}
+
+	// Huffman coded
+	if len(b) < 5 {
+		return 0, errZstd
+	}
+	h := binary.LittleEndian.Uint64(append(b[:5:5], 0, 0, 0))
+	var size, csize, n int
+	streams := 4
+	switch format {
+	case 0, 1:
+		if format == 0 {
+			streams = 1
+		}
+		size, csize, n = int(h>>4&0x3FF), int(h>>14&0x3FF), 3
+	case 2:
+		size, csize, n = int(h>>4&0x3FFF), int(h>>18&0x3FFF), 4
+	case 3:
+		size, csize, n = int(h>>4&0x3FFFF), int(h>>22&0x3FFFF), 5
+	}
+	if size > maxBlock || len(b) < n+csize {
+		return 0, errZstd
+	}
+	data := b[n : n+csize]
+	if typ == 2 {
+		huff, k, err := readZstdHuffman(data)
+		if err != nil {
+			return 0, err
+		}
+		z.huff = huff
+		data = data[k:]
+	} else if z.huff == nil {
+		return 0, errZstd
+	}
+
+	z.lits = grow(z.lits[:0], size)
+	if streams == 1 {
+		if err := z.huff.decode(z.lits, data); err != nil {
+			return 0, err
+		}
+		return n + csize, nil
+	}
+	if len(data) < 6 {
+		return 0, errZstd
+	}
+	seg := (size + 3) / 4
+	if seg*3 > size {
+		return 0, errZstd
+	}
+	data, sizes := data[6:], data[:6]
+	for i := 0; i < 4; i++ {
+		k := len(data)
+		if i < 3 {
+			k = int(binary.LittleEndian.Uint16(sizes[2*i:]))
+		}
+		end := seg * (i + 1)
+		if i == 3 {
+			end = size
+		}
+		if k > len(data) {
+			return 0, errZstd
+		}
+		if err := z.huff.decode(z.lits[seg*i:end], data[:k]); err != nil {
+			return 0, err
+		}
+		data = data[k:]
+	}
+	return n + csize, nil
+}
+
+// sequences decodes and executes the nseq sequences of the bitstream b,
+// which may extend hist up to limit
+func (z *zstdReader) sequences(b []byte, nseq, limit int) error {
+	br, err := newBackwardBits(b)
+	if err != nil {
+		return err
+	}
+	llState := br.read(z.ll.log)
+	ofState := br.read(z.of.log)
+	mlState := br.read(z.ml.log)
+
+	lits := z.lits
+	for i := 0; i < nseq; i++ {
+		ofCode := z.of.entries[ofState].sym
+		mlCode := z.ml.entries[mlState].sym
+		llCode := z.ll.entries[llState].sym
+		if ofCode > 31 || mlCode > 52 || llCode > 35 {
+			return errZstd
+		}
+		offset := 1<<ofCode + br.read(int(ofCode))
+		ml := int(zstdMLBase[mlCode]) + br.read(zstdMLBits[mlCode])
+		ll := int(zstdLLBase[llCode]) + br.read(zstdLLBits[llCode])
+
+		if offset > 3 {
+			offset -= 3
+			z.rep = [3]int{offset, z.rep[0], z.rep[1]}
+		} else {
+			if ll == 0 {
+				offset++
+			}
+			switch offset {
+			case 1:
+				offset = z.rep[0]
+			case 2:
+				offset = z.rep[1]
+				z.rep[0], z.rep[1] = offset, z.rep[0]
+			case 3:
+				offset = z.rep[2]
+				z.rep = [3]int{offset, z.rep[0], z.rep[1]}
+			case 4:
+				offset = z.rep[0] - 1
+				z.rep = [3]int{offset, z.rep[0], z.rep[1]}
+			}
+		}
+
+		if i < nseq-1 {
+			e := z.ll.entries[llState]
+			llState = int(e.base) + br.read(e.bits)
+			e = z.ml.entries[mlState]
+			mlState = int(e.base) + br.read(e.bits)
+			e = z.of.entries[ofState]
+			ofState = int(e.base) + br.read(e.bits)
+		}
+		if br.pos < 0 {
+			return errZstd
+		}
+
+		if ll > len(lits) || len(z.hist)+ll+ml > limit {
+			return errZstd
+		}
+		z.hist = append(z.hist, lits[:ll]...)
+		lits = lits[ll:]
+		if offset <= 0 || offset > len(z.hist) {
+			return errZstd
+		}
+		z.hist = appendMatch(z.hist, offset, ml)
+	}
+	if br.pos != 0 {
+		return errZstd
+	}
+	if len(z.hist)+len(lits) > limit {
+		return errZstd
+	}
+	z.hist = append(z.hist, lits...)
+	return nil
+}
+
+// seqTable sets t to the decoding table of a sequence symbol type for the
+// compression mode and returns the remainder of b
+func seqTable(b []byte, mode byte, t **fseTable, def *fseTable, maxSym, maxLog int) ([]byte, error) {
+	switch mode {
+	case 0: // predefined
+		*t = def
+	case 1: // RLE
+		if len(b) == 0 || int(b[0]) > maxSym {
+			return nil, errZstd
+		}
+		*t = &fseTable{entries: []fseEntry{{sym: b[0]}}}
+		b = b[1:]
+	case 2: // FSE compressed
+		ft, n, err := readFSETable(b, maxSym, maxLog)
+		if err != nil {
+			return nil, err
+		}
+		*t = ft
+		b = b[n:]
+	case 3: // repeat
+		if *t == nil {
+			return nil, errZstd
+		}
+	}
+	return b, nil
+}
+
+// appendMatch appends n bytes copied from offset bytes back in b, which may
+// overlap the appended bytes
+func appendMatch(b []byte, offset, n int) []byte {
+	start := len(b)
+	b = grow(b, n)
+	src := start - offset
+	for n > 0 {
+		k := copy(b[start:start+n], b[src:start])
+		start += k
+		n -= k
+	}
+	return b
+}
+
+// grow extends b by n bytes
+func grow(b []byte, n int) []byte {
+	if cap(b)-len(b) < n {
+		c := make([]byte, len(b), 2*cap(b)+n)
+		copy(c, b)
+		b = c
+	}
+	return b[:len(b)+n]
+}
+
+// noEOF turns io.EOF into io.ErrUnexpectedEOF
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// fseTable is an FSE decoding table
+type fseTable struct {
+	log     int
+	entries []fseEntry
+}
+
+type fseEntry struct {
+	sym  uint8
+	bits int
+	base uint16
+}
+
+// readFSETable reads an FSE table description from the start of b and
+// returns the decoding table and the size of the description
+func readFSETable(b []byte, maxSym, maxLog int) (*fseTable, int, error) {
+	br := forwardBits{b: b}
+	log := int(br.read(4)) + 5
+	if log > maxLog {
+		return nil, 0, errZstd
+	}
+	norm := make([]int, maxSym+1)
+	remaining := 1<<log + 1
+	threshold := 1 << log
+	nbits := log + 1
+	sym := 0
+	prev0 := false
+	for remaining > 1 && sym <= maxSym {
+		if prev0 {
+			for {
+				r := int(br.read(2))
+				sym += r
+				if r != 3 {
+					break
+				}
+			}
+			if sym > maxSym {
+				return nil, 0, errZstd
+			}
+		}
+		max := 2*threshold - 1 - remaining
+		v := int(br.peek(nbits))
+		var count int
+		if v&(threshold-1) < max {
+			count = v & (threshold - 1)
+			br.pos += nbits - 1
+		} else {
+			count = v & (2*threshold - 1)
+			if count >= threshold {
+				count -= max
+			}
+			br.pos += nbits
+		}
+		count--
+		if count < 0 {
+			remaining += count
+		} else {
+			remaining -= count
+		}
+		norm[sym] = count
+		sym++
+		prev0 = count == 0
+		for remaining < threshold && remaining > 1 {
+			nbits--
+			threshold >>= 1
+		}
+	}
+	n := (br.pos + 7) / 8
+	if remaining != 1 || n > len(b) {
+		return nil, 0, errZstd
+	}
+	t, err := buildFSETable(norm, log)
+	return t, n, err
+}
+
+// buildFSETable returns the decoding table of the normalized counts
+func buildFSETable(norm []int, log int) (*fseTable, error) {
+	size := 1 << log
+	t := &fseTable{log: log, entries: make([]fseEntry, size)}
+	next := make([]int, len(norm))
+	high := size - 1
+	for s, c := range norm {
+		if c == -1 {
+			t.entries[high].sym = uint8(s)
+			high--
+			next[s] = 1
+		} else {
+			next[s] = c
+		}
+	}
+	pos, step, mask := 0, size>>1+size>>3+3, size-1
+	for s, c := range norm {
+		for i := 0; i < c; i++ {
+			t.entries[pos].sym = uint8(s)
+			pos = (pos + step) & mask
+			for pos > high {
+				pos = (pos + step) & mask
+			}
+		}
+	}
+	if pos != 0 {
+		return nil, errZstd
+	}
+	for i := range t.entries {
+		e := &t.entries[i]
+		state := next[e.sym]
+		next[e.sym]++
+		e.bits = log + 1 - bits.Len(uint(state))
+		e.base = uint16(state<<e.bits - size)
+	}
+	return t, nil
+}
+
+// zstdDefaultTable returns the predefined decoding table of the
+// distribution
+func zstdDefaultTable(norm []int, log int) *fseTable {
+	t, err := buildFSETable(norm, log)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+var (
+	zstdLLDefault = zstdDefaultTable([]int{
+		4, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 2, 1, 1, 1, 1, 1,
+		-1, -1, -1, -1,
+	}, 6)
+	zstdMLDefault = zstdDefaultTable([]int{
+		1, 4, 3, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, -1, -1,
+		-1, -1, -1, -1, -1,
+	}, 6)
+	zstdOFDefault = zstdDefaultTable([]int{
+		1, 1, 1, 1, 1, 1, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, -1, -1, -1, -1, -1,
+	}, 5)
+
+	zstdLLBase = [36]uint32{
+		0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+		16, 18, 20, 22, 24, 28, 32, 40, 48, 64, 128, 256, 512, 1024, 2048, 4096,
+		8192, 16384, 32768, 65536,
+	}
+	zstdLLBits = [36]int{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 1, 1, 1, 2, 2, 3, 3, 4, 6, 7, 8, 9, 10, 11, 12,
+		13, 14, 15, 16,
+	}
+	zstdMLBase = [53]uint32{
+		3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18,
+		19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34,
+		35, 37, 39, 41, 43, 47, 51, 59, 67, 83, 99, 131, 259, 515, 1027, 2051,
+		4099, 8195, 16387, 32771, 65539,
+	}
+	zstdMLBits = [53]int{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 1, 1, 1, 2, 2, 3, 3, 4, 4, 5, 7, 8, 9, 10, 11,
+		12, 13, 14, 15, 16,
+	}
+)
+
+// zstdHuffman is a Huffman decoding table of literals
+type zstdHuffman struct {
+	log     int
+	entries []huffEntry
+}
+
+type huffEntry struct {
+	sym  byte
+	bits uint8
+}
+
+// readZstdHuffman reads a Huffman tree description from the start of b and
+// returns the decoding table and the size of the description
+func readZstdHuffman(b []byte) (*zstdHuffman, int, error) {
+	if len(b) == 0 {
+		return nil, 0, errZstd
+	}
+	var weights []byte
+	n := int(b[0])
+	if n >= 128 {
+		// 4 bit weights
+		nw := n - 127
+		n = (nw + 1) / 2
+		if len(b) < 1+n {
+			return nil, 0, errZstd
+		}
+		weights = make([]byte, nw, nw+1)
+		for i := range weights {
+			weights[i] = b[1+i/2] >> 4
+			if i%2 == 1 {
+				weights[i] = b[1+i/2] & 15
+			}
+		}
+	} else {
+		// FSE compressed weights
+		if len(b) < 1+n {
+			return nil, 0, errZstd
+		}
+		var err error
+		if weights, err = fseWeights(b[1 : 1+n]); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	total, ones := 0, 0
+	for _, w := range weights {
+		if w == 1 {
+			ones++
+		}
+		if w > 12 {
+			return nil, 0, errZstd
+		}
+		if w > 0 {
+			total += 1 << (w - 1)
+		}
+	}
+	if total == 0 {
+		return nil, 0, errZstd
+	}
+	// the weight of the last symbol is implied
+	log := bits.Len(uint(total))
+	rest := 1<<log - total
+	if log > 12 || rest&(rest-1) != 0 {
+		return nil, 0, errZstd
+	}
+	weights = append(weights, byte(bits.Len(uint(rest))))
+	if rest == 1 {
+		ones++
+	}
+	if ones < 2 || ones%2 != 0 {
+		return nil, 0, errZstd
+	}
+
+	h := &zstdHuffman{log: log, entries: make([]huffEntry, 1<<log)}
+	pos := 0
+	for w := 1; w <= log; w++ {
+		for s, sw := range weights {
+			if int(sw) != w {
+				continue
+			}
+			e := huffEntry{sym: byte(s), bits: uint8(log + 1 - w)}
+			for i := 0; i < 1<<(w-1); i++ {
+				h.entries[pos] = e
+				pos++
+			}
+		}
+	}
+	return h, 1 + n, nil
+}
+
+// fseWeights decodes FSE compressed Huffman weights
+func fseWeights(b []byte) ([]byte, error) {
+	t, n, err := readFSETable(b, 255, 6)
+	if err != nil {
+		return nil, err
+	}
+	br, err := newBackwardBits(b[n:])
+	if err != nil {
+		return nil, err
+	}
+	// the two interleaved states end when more bits are read than available
+	var weights []byte
+	s := [2]int{br.read(t.log), br.read(t.log)}
+	for i := 0; len(weights) < 255; i ^= 1 {
+		e := t.entries[s[i]]
+		weights = append(weights, e.sym)
+		s[i] = int(e.base) + br.read(e.bits)
+		if br.pos < 0 {
+			if len(weights) == 255 {
+				break
+			}
+			return append(weights, t.entries[s[i^1]].sym), nil
+		}
+	}
+	return nil, errZstd
+}
+
+// decode decodes the Huffman coded stream b into dst
+func (h *zstdHuffman) decode(dst, b []byte) error {
+	br, err := newBackwardBits(b)
+	if err != nil {
+		return err
+	}
+	for i := range dst {
+		e := h.entries[br.peek(h.log)]
+		dst[i] = e.sym
+		br.pos -= int(e.bits)
+	}
+	if br.pos != 0 {
+		return errZstd
+	}
+	return nil
+}
+
+// forwardBits reads bits from the start of b, least significant bits first.
+// Bits beyond the end of b are zero.
+type forwardBits struct {
+	b   []byte
+	pos int
+}
+
+func (br *forwardBits) peek(n int) uint64 {
+	var v uint64
+	for i := 0; i < (br.pos&7+n+7)/8; i++ {
+		if k := br.pos/8 + i; k < len(br.b) {
+			v |= uint64(br.b[k]) << (8 * i)
+		}
+	}
+	return v >> (br.pos & 7) & (1<<n - 1)
+}
+
+func (br *forwardBits) read(n int) uint64 {
+	v := br.peek(n)
+	br.pos += n
+	return v
+}
+
+// backwardBits reads bits from the end of b, whose last byte is padded
+// with a 1 bit and zeros. pos is the number of bits left, which is negative
+// if more bits were read than available. These bits are zero.
+type backwardBits struct {
+	b   []byte
+	pos int
+}
+
+func newBackwardBits(b []byte) (*backwardBits, error) {
+	if len(b) == 0 || b[len(b)-1] == 0 {
+		return nil, errZstd
+	}
+	return &backwardBits{b: b, pos: 8*len(b) - 9 + bits.Len8(b[len(b)-1])}, nil
+}
+
+// peek returns the next n bits without consuming them
+func (br *backwardBits) peek(n int) int {
+	start := br.pos - n
+	shift := 0
+	if start < 0 {
+		shift, n, start = -start, n+start, 0
+	}
+	if n <= 0 {
+		return 0
+	}
+	var v uint64
+	k := start / 8
+	for i := 0; i < 8 && k+i < len(br.b); i++ {
+		v |= uint64(br.b[k+i]) << (8 * i)
+	}
+	return int(v>>(start&7)&(1<<n-1)) << shift
+}
+
+func (br *backwardBits) read(n int) int {
+	v := br.peek(n)
+	br.pos -= n
+	return v
+}
+
+// xxhash64 computes the XXH64 hash with seed 0 of the frame content
+type xxhash64 struct {
+	v     [4]uint64
+	buf   [32]byte
+	n     int
+	total uint64
+}
+
+// the primes are variables as the seeds overflow in constant expressions
+var (
+	xxPrime1 uint64 = 11400714785074694791
+	xxPrime2 uint64 = 14029467366897019727
+	xxPrime3 uint64 = 1609587929392839161
+	xxPrime4 uint64 = 9650029242287828579
+	xxPrime5 uint64 = 2870177450012600261
+)
+
+func (h *xxhash64) reset() {
+	*h = xxhash64{v: [4]uint64{xxPrime1 + xxPrime2, xxPrime2, 0, -xxPrime1}}
+}
+
+func xxRound(acc, v uint64) uint64 {
+	return bits.RotateLeft64(acc+v*xxPrime2, 31) * xxPrime1
+}
+
+func (h *xxhash64) write(b []byte) {
+	h.total += uint64(len(b))
+	if h.n > 0 {
+		k := copy(h.buf[h.n:], b)
+		h.n += k
+		b = b[k:]
+		if h.n < 32 {
+			return
+		}
+		h.stripe(h.buf[:])
+		h.n = 0
+	}
+	for ; len(b) >= 32; b = b[32:] {
+		h.stripe(b)
+	}
+	h.n = copy(h.buf[:], b)
+}
+
+func (h *xxhash64) stripe(b []byte) {
+	for i := range h.v {
+		h.v[i] = xxRound(h.v[i], binary.LittleEndian.Uint64(b[8*i:]))
+	}
+}
+
+func (h *xxhash64) sum() uint64 {
+	var s uint64
+	if h.total >= 32 {
+		s = bits.RotateLeft64(h.v[0], 1) + bits.RotateLeft64(h.v[1], 7) +
+			bits.RotateLeft64(h.v[2], 12) + bits.RotateLeft64(h.v[3], 18)
+		for _, v := range h.v {
+			s = (s^xxRound(0, v))*xxPrime1 + xxPrime4
+		}
+	} else {
+		s = xxPrime5
+	}
+	s += h.total
+
+	b := h.buf[:h.n]
+	for ; len(b) >= 8; b = b[8:] {
+		s ^= xxRound(0, binary.LittleEndian.Uint64(b))
+		s = bits.RotateLeft64(s, 27)*xxPrime1 + xxPrime4
+	}
+	if len(b) >= 4 {
+		s ^= uint64(binary.LittleEndian.Uint32(b)) * xxPrime1
+		s = bits.RotateLeft64(s, 23)*xxPrime2 + xxPrime3
+		b = b[4:]
+	}
+	for _, c := range b {
+		s ^= uint64(c) * xxPrime5
+		s = bits.RotateLeft64(s, 11) * xxPrime1
+	}
+	s ^= s >> 33
+	s *= xxPrime2
+	s ^= s >> 29
+	s *= xxPrime3
+	s ^= s >> 32
+	return s
+}
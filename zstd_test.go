@@ -0,0 +1,67 @@
+package s3
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+// testLines returns the plaintext of the zstd and brotli fixtures
+func testLines() string {
+	var b strings.Builder
+	for i := 0; i < 300; i++ {
+		fmt.Fprintf(&b, "line %d: the quick brown fox jumps over the lazy dog\n", i*i%997)
+	}
+	return b.String()
+}
+
+// zstdLines is testLines compressed with zstd -19
+const zstdLines = `
+KLUv/QRodRQAdvZtGXA5LAdQ+kPpD6WP///VJpmSlCnxj0/vJg59AF8AXQCsBFVpLqR8UgX9
+aHxoFo1GhttJKsSONsgxISTZJsLzo3I9xeiMPDPzr+0kuyeNuExNfa5prT+akokY6YyvEm39
+OyOLEKnpp6RVPgIAAYQCBw0bBDSYYOAChAOFCA4sLDhkgHDg8AADhAkDDgwIKLAg4WAgQYVD
+gDgYeKCgQb0fNFvxppYpIVwF0T2JMXkmq/mr49gie7iyGUt3VjcTY18zfaYjRUWRiaWM3O9q
+3hV16uxwhAvWSthyOBtFi27VUHAmm1MdJ8t0Zsc3vqlkTlvIzOWOqXxm+EtpctoN9RkKoTa4
+uVkKP0E3qeNCiif5KwnJ6ZGzyygmJZaRe5BO8TjK+S+qWFefGdfrkehVxM1U/VBsb/OSuOVF
+3BqnTtMKhm+2wfE93dif3NIpc8X4VC4PLW61NPdzb1YFFdNjV2vzOuRC5EcMmxeSrjp9fZS6
+LsOahEyfmKAKCrmxaC9iU8yC2hOH8Zo1RaNE1VZ7JnRsFZYY37i0Kq7u0pdiFHcrmmhif5My
+Ka04Sy40rMd8ipFVv5E91iUcCoEtqCEMS9/Z73F3mgMSSBD4//8R/AHVqwEICEAgHFLgABAE
+kgGIOAABAAgBgEBggkAEAAITAAKACAIAQEQAVxQAOACBDhARggUAAQEABAICEAAIwAGCAGAA
+TwBoAEEIAYABQAQAL/iIhA2AAHQJCCwADAAAgAATARABwEAAQwFAQCkCAQAAEAAIAx2AMZAA
+MBAAAAJkBCACgIEA4BAIDCQIQCAU6AAMBCAECyDiCACOGAYBisQgY4QRTQoymDyekpzJQxGI
+ABY4o4VFRLOuClAtAGoVMMwlMA==`
+
+func unbase64(t *testing.T, s string) []byte {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestZstdReader(t *testing.T) {
+	lines := unbase64(t, zstdLines)
+	for _, tt := range []struct {
+		name string
+		data []byte
+		want string
+		err  error
+	}{
+		{"lines", lines, testLines(), nil},
+		// two frames with a skippable frame in between
+		{"frames", unbase64(t, "KLUv/QRYOQAAaGVsbG8sIM+5PnNTKk0YBAAAAHNraXAotS/9BFgpAAB3b3JsZO9R7mY="), "hello, world", nil},
+		{"empty", nil, "", nil},
+		{"checksum", append(lines[:len(lines)-1:len(lines)-1], lines[len(lines)-1]^1), "", ErrChecksumMismatch},
+		{"truncated", lines[:len(lines)/2], "", io.ErrUnexpectedEOF},
+		{"magic", []byte("not zstd"), "", errZstd},
+	} {
+		data, err := ioutil.ReadAll(newZstdReader(bytes.NewReader(tt.data)))
+		if err != tt.err || tt.err == nil && string(data) != tt.want {
+			t.Errorf("%s: %q %v", tt.name, data, err)
+		}
+	}
+}